@@ -10,6 +10,33 @@ func init() {
 	Config.SetDefault("GEODB_PATH", "/tmp/geodb")
 	Config.SetDefault("GEODB_GC_INTERVAL", "5m")
 	Config.SetDefault("GEODB_GMAPS_CACHE_DURATION", "1h")
+	Config.SetDefault("GEODB_MAPS_CACHE_PRECISION", 8)
+	Config.SetDefault("GEODB_TIMEZONE_CACHE_PRECISION", 8)
+	Config.SetDefault("GEODB_MAPS_CACHE_MAX_ENTRIES", 100000)
+	Config.SetDefault("GEODB_MAPS_QPS", 50)
+	Config.SetDefault("GEODB_MAPS_DAILY_BUDGET", 0)
+	Config.SetDefault("GEODB_MAPS_BREAKER_FAILURES", 5)
+	Config.SetDefault("GEODB_MAPS_BREAKER_COOLDOWN", "30s")
+	Config.SetDefault("GEODB_GAZETTEER_MAX_DISTANCE", 50000)
+	Config.SetDefault("GEODB_ENRICHMENT_MODE", "sync")
+	Config.SetDefault("GEODB_ENRICHMENT_WORKERS", 8)
+	Config.SetDefault("GEODB_ENRICHMENT_QUEUE_SIZE", 1000)
+	Config.SetDefault("GEODB_ENRICHMENT_OVERFLOW_POLICY", "block")
+	Config.SetDefault("GEODB_ENRICHMENT_OVERFLOW_TIMEOUT", "1s")
+	Config.SetDefault("GEODB_HISTORY_RETENTION", "24h")
+	Config.SetDefault("GEODB_DWELL_THRESHOLD", "5m")
+	Config.SetDefault("GEODB_DWELL_INTERVAL", "30s")
+	Config.SetDefault("GEODB_EXPIRY_INTERVAL", "1s")
+	Config.SetDefault("GEODB_SET_STREAM_BATCH_SIZE", 500)
+	Config.SetDefault("GEODB_STALE_WRITE_POLICY", "reject")
+	Config.SetDefault("GEODB_STREAM_BUFFER_SIZE", 1000)
+	Config.SetDefault("GEODB_STREAM_OVERFLOW_POLICY", "drop_oldest")
+	Config.SetDefault("GEODB_CHANGELOG_SIZE", 100000)
+	Config.SetDefault("GEODB_WEBHOOK_WORKERS", 8)
+	Config.SetDefault("GEODB_WEBHOOK_QUEUE_SIZE", 1000)
+	Config.SetDefault("GEODB_WEBHOOK_MAX_ATTEMPTS", 5)
+	Config.SetDefault("GEODB_WEBHOOK_BACKOFF", "1s")
+	Config.SetDefault("GEODB_WEBHOOK_TIMEOUT", "10s")
 	Config.AutomaticEnv()
 }
 
@@ -0,0 +1,59 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateArea checks that exactly one of the circle or polygon of an area(geofence, spatial stream) is present and valid
+func ValidateArea(circle *api.Bound, polygon *api.Polygon) error {
+	switch {
+	case circle != nil && polygon != nil:
+		return status.Error(codes.InvalidArgument, "area may only have one of circle or polygon")
+	case circle != nil:
+		if circle.Center == nil || circle.Radius <= 0 {
+			return status.Error(codes.InvalidArgument, "area circle requires a center and a radius")
+		}
+		return nil
+	case polygon != nil:
+		return validatePolygon(polygon)
+	default:
+		return status.Error(codes.InvalidArgument, "area requires one of circle or polygon")
+	}
+}
+
+// AreaContains returns true if the point is inside the circle or polygon
+func AreaContains(circle *api.Bound, polygon *api.Polygon, point *api.Point) bool {
+	if point == nil {
+		return false
+	}
+	if circle != nil {
+		return distance(circle.Center, point) <= circle.Radius
+	}
+	return polygonContains(polygon, geo.NewPointFromLatLng(point.Lat, point.Lon))
+}
+
+// areaBound returns the bound of the circle or polygon
+func areaBound(circle *api.Bound, polygon *api.Polygon) *geo.Bound {
+	if circle != nil {
+		return geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(circle.Center.Lat, circle.Center.Lon), circle.Radius)
+	}
+	return polygonBound(polygon)
+}
+
+// ScanArea returns the current object details inside the circle or polygon that match the filter
+func ScanArea(db *badger.DB, circle *api.Bound, polygon *api.Polygon, filter *api.MetadataFilter) (map[string]*api.ObjectDetail, error) {
+	if err := ValidateArea(circle, polygon); err != nil {
+		return nil, err
+	}
+	geoBound := areaBound(circle, polygon)
+	objects, _, err := Page(0, func(fn ObjectFunc) error {
+		return scanKeys(db, geoBound, nil, filter, func(point *geo.Point) bool {
+			return AreaContains(circle, polygon, &api.Point{Lat: point.Lat(), Lon: point.Lng()})
+		}, "", fn)
+	})
+	return objects, err
+}
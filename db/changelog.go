@@ -0,0 +1,298 @@
+package db
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+)
+
+const (
+	// changeLogPrefix namespaces the change log entries: changeLogPrefix + zero padded sequence
+	changeLogPrefix = reservedPrefix + "changelog_"
+	// changeLogSequence stores the last sequence that was assigned
+	changeLogSequence = reservedPrefix + "meta_changelog_sequence"
+)
+
+var (
+	// publishMu guards the change log state of every database. It is only held while sequences are assigned and committed writes are
+	// queued for publishing, never during commits or publishing.
+	publishMu = &sync.Mutex{}
+	// changeLogs holds the change log state of every database, guarded by publishMu
+	changeLogs = map[*badger.DB]*changeLogBounds{}
+)
+
+// changeLogBounds are the last assigned sequence, the oldest sequence left in the change log and the writes waiting to be published
+type changeLogBounds struct {
+	last   uint64
+	oldest uint64
+	// published is the last sequence queued for publishing. committed holds the sequences that finished committing(nil if the commit
+	// failed) before an earlier sequence did, ready the publications waiting for the publisher, publishing is true while one is running.
+	published  uint64
+	committed  map[uint64]*publication
+	ready      []*publication
+	publishing bool
+}
+
+// publication is an object detail to publish to the hub once every earlier sequence was published
+type publication struct {
+	hub    *stream.Hub
+	detail *api.ObjectDetail
+}
+
+func changeLogKey(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", changeLogPrefix, sequence))
+}
+
+func lastSequence(txn *badger.Txn) (uint64, error) {
+	item, err := txn.Get([]byte(changeLogSequence))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(res), nil
+}
+
+func setLastSequence(txn *badger.Txn, sequence uint64) error {
+	bits := make([]byte, 8)
+	binary.BigEndian.PutUint64(bits, sequence)
+	return txn.SetEntry(&badger.Entry{
+		Key:      []byte(changeLogSequence),
+		Value:    bits,
+		UserMeta: changeLogMeta,
+	})
+}
+
+// oldestSequence returns the sequence of the first change log entry, last+1 if the change log is empty
+func oldestSequence(txn *badger.Txn, last uint64) (uint64, error) {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	prefix := []byte(changeLogPrefix)
+	oldest := last + 1
+	if iter.Seek(prefix); iter.ValidForPrefix(prefix) {
+		if _, err := fmt.Sscanf(string(iter.Item().Key()[len(changeLogPrefix):]), "%d", &oldest); err != nil {
+			return 0, err
+		}
+	}
+	return oldest, nil
+}
+
+// newestSequence returns the sequence of the last change log entry, 0 if the change log is empty
+func newestSequence(txn *badger.Txn) (uint64, error) {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Reverse = true
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	prefix := []byte(changeLogPrefix)
+	var newest uint64
+	if iter.Seek(append([]byte(changeLogPrefix), 0xFF)); iter.ValidForPrefix(prefix) {
+		if _, err := fmt.Sscanf(string(iter.Item().Key()[len(changeLogPrefix):]), "%d", &newest); err != nil {
+			return 0, err
+		}
+	}
+	return newest, nil
+}
+
+// changeLogOf returns the change log state of the database, loading it on first use. The caller must hold publishMu.
+func changeLogOf(db *badger.DB) (*changeLogBounds, error) {
+	if bounds, ok := changeLogs[db]; ok {
+		return bounds, nil
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	last, err := lastSequence(txn)
+	if err != nil {
+		return nil, err
+	}
+	// writes commit out of sequence order, so the stored last sequence may be behind the newest change log entry
+	newest, err := newestSequence(txn)
+	if err != nil {
+		return nil, err
+	}
+	if newest > last {
+		last = newest
+	}
+	oldest, err := oldestSequence(txn, last)
+	if err != nil {
+		return nil, err
+	}
+	changeLogs[db] = &changeLogBounds{last: last, oldest: oldest, published: last, committed: map[uint64]*publication{}}
+	return changeLogs[db], nil
+}
+
+// commitPublish assigns consecutive sequences to the object details and appends them to the change log inside the write transaction,
+// calls store(optional) to write the objects that keep their detail, commits the transaction and publishes the details in sequence order.
+// The write and its change log entries share one commit, so a committed write is never missing from the change log and stored details carry
+// the sequence they were published with. The change log keeps the latest GEODB_CHANGELOG_SIZE entries. Writes commit concurrently, only
+// assigning sequences and queueing the committed details for publishing are serialized.
+func commitPublish(db *badger.DB, txn *badger.Txn, hub *stream.Hub, details []*api.ObjectDetail, store func() error) error {
+	publishMu.Lock()
+	bounds, err := changeLogOf(db)
+	if err != nil {
+		publishMu.Unlock()
+		return err
+	}
+	// the last sequence is tracked in memory rather than read in the transaction, so concurrent writers don't conflict on it
+	first := bounds.last + 1
+	bounds.last += uint64(len(details))
+	sequence, oldest := bounds.last, bounds.oldest
+	publishMu.Unlock()
+	err = appendChangeLog(txn, details, first, oldest, store)
+	if err == nil {
+		err = txn.Commit()
+	}
+	publishMu.Lock()
+	// writers that failed to commit leave the entries they meant to delete to the next writer
+	if kept := firstKept(sequence); err == nil && kept > bounds.oldest {
+		bounds.oldest = kept
+	}
+	for i, detail := range details {
+		if err != nil {
+			detail = nil
+		}
+		bounds.committed[first+uint64(i)] = &publication{hub: hub, detail: detail}
+	}
+	for next, ok := bounds.committed[bounds.published+1]; ok; next, ok = bounds.committed[bounds.published+1] {
+		delete(bounds.committed, bounds.published+1)
+		bounds.published++
+		if next.detail != nil {
+			bounds.ready = append(bounds.ready, next)
+		}
+	}
+	publishMu.Unlock()
+	if err != nil {
+		return err
+	}
+	publishReady(bounds)
+	return nil
+}
+
+// appendChangeLog sets the change log entries of the details starting at sequence first, deletes the entries that fall out of the window
+// starting at oldest and calls store(optional)
+func appendChangeLog(txn *badger.Txn, details []*api.ObjectDetail, first, oldest uint64, store func() error) error {
+	sequence := first - 1
+	for _, detail := range details {
+		sequence++
+		detail.Sequence = sequence
+		bits, err := proto.Marshal(detail)
+		if err != nil {
+			return err
+		}
+		if err := txn.SetEntry(&badger.Entry{
+			Key:      changeLogKey(sequence),
+			Value:    bits,
+			UserMeta: changeLogMeta,
+		}); err != nil {
+			return err
+		}
+	}
+	// only the entries that fell out of the window are deleted
+	for kept := firstKept(sequence); oldest < kept; oldest++ {
+		if err := txn.Delete(changeLogKey(oldest)); err != nil {
+			return err
+		}
+	}
+	if err := setLastSequence(txn, sequence); err != nil {
+		return err
+	}
+	if store != nil {
+		return store()
+	}
+	return nil
+}
+
+// firstKept returns the oldest sequence the change log keeps once sequence was appended(GEODB_CHANGELOG_SIZE entries)
+func firstKept(sequence uint64) uint64 {
+	size := uint64(config.Config.GetInt64("GEODB_CHANGELOG_SIZE"))
+	if sequence < size {
+		return 0
+	}
+	return sequence + 1 - size
+}
+
+// publishReady publishes the queued publications in order. Concurrent callers leave them to the one already publishing, which keeps
+// publishing until the queue is empty.
+func publishReady(bounds *changeLogBounds) {
+	publishMu.Lock()
+	defer publishMu.Unlock()
+	if bounds.publishing {
+		return
+	}
+	bounds.publishing = true
+	for len(bounds.ready) > 0 {
+		ready := bounds.ready
+		bounds.ready = nil
+		publishMu.Unlock()
+		for _, next := range ready {
+			next.hub.PublishObject(next.detail)
+		}
+		publishMu.Lock()
+	}
+	bounds.publishing = false
+}
+
+// publishObject appends the object detail to the change log in its own transaction and publishes it to the stream hub
+func publishObject(db *badger.DB, hub *stream.Hub, detail *api.ObjectDetail) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	return commitPublish(db, txn, hub, []*api.ObjectDetail{detail}, nil)
+}
+
+// ReadChangeLog calls fn for every change log entry starting at fromSequence, oldest first, and returns the last sequence that was read
+// (fromSequence-1 if there were none). An OutOfRange error is returned if fromSequence has been truncated from the change log.
+func ReadChangeLog(db *badger.DB, fromSequence uint64, fn func(detail *api.ObjectDetail) error) (uint64, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	last, err := lastSequence(txn)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get sequence: %s", err.Error())
+	}
+	if fromSequence == 0 {
+		fromSequence = 1
+	}
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(changeLogPrefix)
+	oldest, err := oldestSequence(txn, last)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to parse change log key: %s", err.Error())
+	}
+	if fromSequence < oldest {
+		return 0, status.Errorf(codes.OutOfRange, "sequence %d has been truncated from the change log, the oldest available sequence is %d", fromSequence, oldest)
+	}
+	read := fromSequence - 1
+	for iter.Seek(changeLogKey(fromSequence)); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != changeLogMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var detail = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, detail); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if err := fn(detail); err != nil {
+			return 0, err
+		}
+		read = detail.Sequence
+	}
+	return read, nil
+}
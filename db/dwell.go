@@ -0,0 +1,319 @@
+package db
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	// dwellPrefix namespaces the entry timestamps of object/area pairs: dwellPrefix + kind + "_" + base64(object key) + "_" + base64(target key)
+	dwellPrefix   = reservedPrefix + "dwell_"
+	dwellGeofence = "geofence"
+	dwellTracker  = "tracker"
+)
+
+// dwellBatchSize is the max number of dwell states CheckDwell updates in one transaction
+const dwellBatchSize = 100
+
+func dwellSeconds(state *api.DwellState, nowUnix int64) int64 {
+	if nowUnix < state.EnteredUnix {
+		return 0
+	}
+	return nowUnix - state.EnteredUnix
+}
+
+// dwellDue returns true if the dwell threshold has passed and the dwell hasn't been reported yet
+func dwellDue(state *api.DwellState, nowUnix int64) bool {
+	return !state.Fired && state.Threshold > 0 && dwellSeconds(state, nowUnix) >= state.Threshold
+}
+
+func dwellKey(kind, key, target string) []byte {
+	return []byte(dwellPrefix + kind + "_" + indexEncoding.EncodeToString([]byte(key)) + "_" + indexEncoding.EncodeToString([]byte(target)))
+}
+
+// dwellThreshold returns seconds or GEODB_DWELL_THRESHOLD if seconds is empty
+func dwellThreshold(seconds int64) int64 {
+	if seconds > 0 {
+		return seconds
+	}
+	return int64(config.Config.GetDuration("GEODB_DWELL_THRESHOLD").Seconds())
+}
+
+func getDwell(txn *badger.Txn, kind, key, target string) (*api.DwellState, error) {
+	item, err := txn.Get(dwellKey(kind, key, target))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if item.UserMeta() != dwellMeta {
+		return nil, nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	var state = &api.DwellState{}
+	if err := proto.Unmarshal(res, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func setDwell(txn *badger.Txn, state *api.DwellState) error {
+	bits, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:      dwellKey(state.Kind, state.Key, state.Target),
+		Value:    bits,
+		UserMeta: dwellMeta,
+	})
+}
+
+// unindexDwell removes the dwell states of the object stored at key inside the given write transaction
+func unindexDwell(txn *badger.Txn, key string) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	var keys [][]byte
+	for _, kind := range []string{dwellGeofence, dwellTracker} {
+		prefix := []byte(dwellPrefix + kind + "_" + indexEncoding.EncodeToString([]byte(key)) + "_")
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			if iter.Item().UserMeta() == dwellMeta {
+				keys = append(keys, iter.Item().KeyCopy(nil))
+			}
+		}
+	}
+	iter.Close()
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateDwell records whether the object is inside the target at nowUnix inside the given write transaction.
+// It returns the seconds the object has been inside(or was inside before leaving), whether the dwell threshold has passed,
+// and whether it passed with this update.
+func updateDwell(txn *badger.Txn, kind, key, target string, inside bool, nowUnix, threshold int64) (int64, bool, bool, error) {
+	state, err := getDwell(txn, kind, key, target)
+	if err != nil {
+		return 0, false, false, err
+	}
+	if !inside {
+		if state == nil {
+			return 0, false, false, nil
+		}
+		return dwellSeconds(state, nowUnix), state.Fired, false, txn.Delete(dwellKey(kind, key, target))
+	}
+	if state == nil {
+		state = &api.DwellState{
+			Kind:        kind,
+			Key:         key,
+			Target:      target,
+			EnteredUnix: nowUnix,
+		}
+	}
+	state.Threshold = threshold
+	crossed := dwellDue(state, nowUnix)
+	if crossed {
+		state.Fired = true
+	}
+	if err := setDwell(txn, state); err != nil {
+		return 0, false, false, err
+	}
+	return dwellSeconds(state, nowUnix), state.Fired, crossed, nil
+}
+
+// updateTrackerDwell updates the dwell fields of the objects tracker events inside the given write transaction
+func updateTrackerDwell(txn *badger.Txn, obj *api.Object, events []*api.TrackerEvent) error {
+	targets := map[string]*api.TrackerEvent{}
+	for _, event := range events {
+		targets[event.GetObject().GetKey()] = event
+	}
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		event, ok := targets[tracker.TargetObjectKey]
+		if !ok {
+			continue
+		}
+		seconds, dwelling, _, err := updateDwell(txn, dwellTracker, obj.Key, tracker.TargetObjectKey, event.Inside, obj.UpdatedUnix, dwellThreshold(tracker.DwellSeconds))
+		if err != nil {
+			return err
+		}
+		event.DwellSeconds = seconds
+		event.Dwelling = dwelling && event.Inside
+	}
+	return nil
+}
+
+// CheckDwell emits the dwell events of every object that has been inside a geofence or overlapping a tracked object for longer than
+// the dwell threshold as of now, so dwelling is reported even if the object stops sending updates. Entries of deleted objects, geofences
+// or trackers are removed. The states are updated in batches of dwellBatchSize, batches that conflict with concurrent writes are left to
+// the next check.
+func CheckDwell(db *badger.DB, hub *stream.Hub, now time.Time) error {
+	nowUnix := now.Unix()
+	txn := db.NewTransaction(false)
+	var states []*api.DwellState
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	prefix := []byte(dwellPrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != dwellMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			iter.Close()
+			txn.Discard()
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var state = &api.DwellState{}
+		if err := proto.Unmarshal(res, state); err != nil {
+			iter.Close()
+			txn.Discard()
+			return status.Errorf(codes.Internal, "failed to unmarshal dwell state: %s", err.Error())
+		}
+		states = append(states, state)
+	}
+	iter.Close()
+	txn.Discard()
+	for start := 0; start < len(states); start += dwellBatchSize {
+		end := start + dwellBatchSize
+		if end > len(states) {
+			end = len(states)
+		}
+		if err := checkDwellBatch(db, hub, states[start:end], nowUnix); err != nil {
+			if err == badger.ErrConflict {
+				continue
+			}
+			return status.Errorf(codes.Internal, "failed to check dwell: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// checkDwellBatch emits the due dwell events of the states in one write transaction. The states are read again, so states that changed
+// since they were listed conflict with the writes that changed them.
+func checkDwellBatch(db *badger.DB, hub *stream.Hub, listed []*api.DwellState, nowUnix int64) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	var (
+		geofenceEvents []*api.GeofenceEvent
+		objects        []*api.ObjectDetail
+		// an object may dwell in several targets of the batch, its detail is read and published once
+		details   = map[string]*api.ObjectDetail{}
+		published = map[string]bool{}
+	)
+	for _, entry := range listed {
+		state, err := getDwell(txn, entry.Kind, entry.Key, entry.Target)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			continue
+		}
+		obj, ok := details[state.Key]
+		if !ok {
+			obj, err = getObjectDetail(txn, state.Key)
+			if err != nil {
+				return err
+			}
+			details[state.Key] = obj
+		}
+		if obj == nil || obj.Object == nil || obj.Object.Point == nil {
+			if err := txn.Delete(dwellKey(state.Kind, state.Key, state.Target)); err != nil {
+				return err
+			}
+			continue
+		}
+		if !dwellDue(state, nowUnix) {
+			continue
+		}
+		switch state.Kind {
+		case dwellGeofence:
+			fence, err := getGeofence(txn, state.Target)
+			if err != nil {
+				return err
+			}
+			if fence == nil || !geofenceContains(fence, obj.Object.Point) {
+				if err := txn.Delete(dwellKey(state.Kind, state.Key, state.Target)); err != nil {
+					return err
+				}
+				continue
+			}
+			geofenceEvents = append(geofenceEvents, &api.GeofenceEvent{
+				Geofence:      fence,
+				Object:        obj.Object,
+				Transition:    api.GeofenceTransition_Dwell,
+				TimestampUnix: nowUnix,
+				DwellSeconds:  dwellSeconds(state, nowUnix),
+			})
+		case dwellTracker:
+			var event *api.TrackerEvent
+			for _, e := range obj.TrackerEvents {
+				if e.GetObject().GetKey() == state.Target && e.Inside {
+					event = e
+				}
+			}
+			if event == nil {
+				if err := txn.Delete(dwellKey(state.Kind, state.Key, state.Target)); err != nil {
+					return err
+				}
+				continue
+			}
+			event.Dwelling = true
+			event.DwellSeconds = dwellSeconds(state, nowUnix)
+			if !published[state.Key] {
+				published[state.Key] = true
+				obj.Event = api.ObjectEventType_Set
+				objects = append(objects, obj)
+			}
+		}
+		state.Fired = true
+		if err := setDwell(txn, state); err != nil {
+			return err
+		}
+	}
+	if err := commitPublish(db, txn, hub, objects, func() error {
+		for _, obj := range objects {
+			if err := storeObject(txn, obj.Object, obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, event := range geofenceEvents {
+		hub.PublishGeofenceEvent(event)
+	}
+	return nil
+}
+
+// WatchDwell runs CheckDwell every GEODB_DWELL_INTERVAL until the context is cancelled
+func WatchDwell(ctx context.Context, db *badger.DB, hub *stream.Hub) error {
+	ticker := time.NewTicker(config.Config.GetDuration("GEODB_DWELL_INTERVAL"))
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := CheckDwell(db, hub, now); err != nil {
+				log.Error(err.Error())
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
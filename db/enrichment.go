@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/metrics"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// DropEnrichments drops lookups that find the enrichment queue full
+	DropEnrichments = "drop"
+	// BlockEnrichments makes the writer wait up to GEODB_ENRICHMENT_OVERFLOW_TIMEOUT for room in the enrichment queue before dropping the lookups
+	BlockEnrichments = "block"
+)
+
+var (
+	enrichmentsOnce sync.Once
+	// enrichments queues the maps lookups deferred in async enrichment mode until WatchEnrichments works them off
+	enrichments chan func()
+)
+
+func enrichmentQueue() chan func() {
+	enrichmentsOnce.Do(func() {
+		enrichments = make(chan func(), config.Config.GetInt("GEODB_ENRICHMENT_QUEUE_SIZE"))
+	})
+	return enrichments
+}
+
+// ParseEnrichmentOverflowPolicy returns an error if the policy isn't one of DropEnrichments or BlockEnrichments
+func ParseEnrichmentOverflowPolicy(policy string) error {
+	switch policy {
+	case DropEnrichments, BlockEnrichments:
+		return nil
+	default:
+		return fmt.Errorf("unknown enrichment overflow policy: %s", policy)
+	}
+}
+
+// enrichAsync returns true if maps lookups are deferred until after objects are committed(GEODB_ENRICHMENT_MODE async)
+func enrichAsync(maps *maps.Client) bool {
+	return maps != nil && config.Config.GetString("GEODB_ENRICHMENT_MODE") == "async"
+}
+
+// needsLookups returns true if building the detail of the object requires maps lookups
+func needsLookups(obj *api.Object) bool {
+	return obj.GetAddress || obj.GetTimezone || len(obj.GetTracking().GetTrackers()) > 0
+}
+
+// enqueueEnrichment queues the job for the enrichment workers. Once the queue is full the job is dropped right away, or after waiting up to
+// GEODB_ENRICHMENT_OVERFLOW_TIMEOUT for room if GEODB_ENRICHMENT_OVERFLOW_POLICY is block. Dropped jobs are counted, their objects keep
+// the details they were committed with.
+func enqueueEnrichment(job func()) {
+	queue := enrichmentQueue()
+	select {
+	case queue <- job:
+		metrics.GaugeEnrichmentQueue(len(queue))
+		return
+	default:
+	}
+	if config.Config.GetString("GEODB_ENRICHMENT_OVERFLOW_POLICY") == BlockEnrichments {
+		timer := time.NewTimer(config.Config.GetDuration("GEODB_ENRICHMENT_OVERFLOW_TIMEOUT"))
+		defer timer.Stop()
+		select {
+		case queue <- job:
+			metrics.GaugeEnrichmentQueue(len(queue))
+			return
+		case <-timer.C:
+		}
+	}
+	metrics.IncEnrichmentDropped()
+}
+
+// enqueueObjectEnrichment queues the maps lookups of the object committed at version
+func enqueueObjectEnrichment(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object, version uint64) {
+	obj = proto.Clone(obj).(*api.Object)
+	enqueueEnrichment(func() {
+		if err := enrichObject(db, maps, hub, obj, version); err != nil {
+			log.Errorf("%s failed to enrich object: %s", obj.Key, err.Error())
+		}
+	})
+}
+
+// enqueueWatcherRefresh queues the refresh of every tracker watching the object committed at version, one job per watcher
+func enqueueWatcherRefresh(db *badger.DB, maps *maps.Client, hub *stream.Hub, key string, version uint64) {
+	txn := db.NewTransaction(false)
+	watchers := trackerWatchers(txn, key)
+	txn.Discard()
+	for _, watcher := range watchers {
+		if watcher == key {
+			continue
+		}
+		watcher := watcher
+		enqueueEnrichment(func() {
+			txn := db.NewTransaction(false)
+			current, err := getObjectDetail(txn, key)
+			txn.Discard()
+			if err != nil {
+				log.Errorf("%s failed to refresh tracker of %s: %s", watcher, key, err.Error())
+				return
+			}
+			// newer versions refresh the trackers themselves, deleted objects refreshed them when they were deleted
+			if current == nil || current.Version != version {
+				return
+			}
+			if err := refreshWatcher(db, maps, hub, watcher, key, current.Object); err != nil {
+				log.Errorf("%s failed to refresh tracker of %s: %s", watcher, key, err.Error())
+			}
+		})
+	}
+}
+
+// enrichObject looks up the address, timezone and tracker directions of the object committed at version, patches them into the stored
+// object detail and publishes it as an Enriched event. Objects that were set again or deleted in the meantime are left alone.
+func enrichObject(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object, version uint64) error {
+	// lookups may take a while, so they are done outside of the write transaction
+	enriched := lookupObject(db, maps, obj)
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	current, err := getObjectDetail(txn, obj.Key)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.Object == nil || current.Version != version {
+		return nil
+	}
+	current.Address = enriched.Address
+	current.Timezone = enriched.Timezone
+	current.UtcOffset = enriched.UtcOffset
+	current.Dst = enriched.Dst
+	directions := map[string]*api.Directions{}
+	for _, event := range enriched.TrackerEvents {
+		directions[event.GetObject().GetKey()] = event.Direction
+	}
+	for _, event := range current.TrackerEvents {
+		if direction, ok := directions[event.GetObject().GetKey()]; ok {
+			event.Direction = direction
+		}
+	}
+	current.Event = api.ObjectEventType_Enriched
+	return commitPublish(db, txn, hub, []*api.ObjectDetail{current}, func() error {
+		return storeObject(txn, current.Object, current)
+	})
+}
+
+// WatchEnrichments runs GEODB_ENRICHMENT_WORKERS workers doing the maps lookups deferred in async enrichment mode until the context is cancelled
+func WatchEnrichments(ctx context.Context) error {
+	queue := enrichmentQueue()
+	wg := &sync.WaitGroup{}
+	for i := 0; i < config.Config.GetInt("GEODB_ENRICHMENT_WORKERS"); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case job := <-queue:
+					metrics.GaugeEnrichmentQueue(len(queue))
+					job()
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
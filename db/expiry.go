@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// expiryPrefix namespaces the expiration index: expiryPrefix + zero padded expires unix + "_" + object key. The entries hold the last
+// version of the object detail since badger no longer returns an object once it expired.
+const expiryPrefix = reservedPrefix + "expiry_"
+
+func expiryKey(expiresUnix int64, key string) []byte {
+	return []byte(fmt.Sprintf("%s%020d_%s", expiryPrefix, expiresUnix, key))
+}
+
+// indexExpiry replaces the expiration index entry of the previous version of the object with the one of the new version
+func indexExpiry(txn *badger.Txn, previous *api.Object, detail *api.ObjectDetail) error {
+	if err := unindexExpiry(txn, previous); err != nil {
+		return err
+	}
+	if detail.Object.ExpiresUnix <= 0 {
+		return nil
+	}
+	bits, err := proto.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:      expiryKey(detail.Object.ExpiresUnix, detail.Object.Key),
+		Value:    bits,
+		UserMeta: expiryMeta,
+	})
+}
+
+func unindexExpiry(txn *badger.Txn, obj *api.Object) error {
+	if obj == nil || obj.ExpiresUnix <= 0 {
+		return nil
+	}
+	return txn.Delete(expiryKey(obj.ExpiresUnix, obj.Key))
+}
+
+// CheckExpirations publishes an Expired event for every object that expired at or before now, removes its index entries and dwell states
+// and refreshes the trackers watching it
+func CheckExpirations(db *badger.DB, hub *stream.Hub, now time.Time) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	prefix := []byte(expiryPrefix)
+	// every entry sorts before the first one expiring after now
+	cutoff := string(expiryKey(now.Unix()+1, ""))
+	var keys [][]byte
+	var expired []*api.ObjectDetail
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix) && string(iter.Item().Key()) < cutoff; iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != expiryMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			iter.Close()
+			return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var detail = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, detail); err != nil {
+			iter.Close()
+			return status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		keys = append(keys, item.KeyCopy(nil))
+		expired = append(expired, detail)
+	}
+	iter.Close()
+	var events []*api.ObjectDetail
+	for i, detail := range expired {
+		if err := txn.Delete(keys[i]); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete expiration: %s", err.Error())
+		}
+		current, err := getObjectDetail(txn, detail.Object.Key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get object: %s", err.Error())
+		}
+		// the object was set again without expiring in between
+		if current != nil {
+			continue
+		}
+		// the object itself expired in badger, its index entries and dwell states are removed like the ones of a deleted object
+		if err := unindexObject(txn, detail.Object); err != nil {
+			return status.Errorf(codes.Internal, "failed to unindex expired key: %s %s", detail.Object.Key, err.Error())
+		}
+		detail.Event = api.ObjectEventType_Expired
+		events = append(events, detail)
+	}
+	if err := commitPublish(db, txn, hub, events, nil); err != nil {
+		return status.Errorf(codes.Internal, "failed to check expirations: %s", err.Error())
+	}
+	for _, detail := range events {
+		refreshWatchers(db, nil, hub, detail.Object.Key, nil)
+	}
+	return nil
+}
+
+// WatchExpirations runs CheckExpirations every GEODB_EXPIRY_INTERVAL until the context is cancelled
+func WatchExpirations(ctx context.Context, db *badger.DB, hub *stream.Hub) error {
+	ticker := time.NewTicker(config.Config.GetDuration("GEODB_EXPIRY_INTERVAL"))
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := CheckExpirations(db, hub, now); err != nil {
+				log.Error(err.Error())
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateMetadataFilter returns an InvalidArgument error if a condition of the filter has the wrong number of values for its operator
+func ValidateMetadataFilter(filter *api.MetadataFilter) error {
+	for _, condition := range filter.GetConditions() {
+		switch condition.GetOperator() {
+		case api.MetadataOperator_Equal, api.MetadataOperator_NotEqual:
+			if len(condition.GetValues()) != 1 {
+				return status.Errorf(codes.InvalidArgument, "metadata condition on %s: %s requires exactly one value", condition.GetKey(), condition.GetOperator())
+			}
+		case api.MetadataOperator_In, api.MetadataOperator_NotIn:
+			if len(condition.GetValues()) == 0 {
+				return status.Errorf(codes.InvalidArgument, "metadata condition on %s: %s requires at least one value", condition.GetKey(), condition.GetOperator())
+			}
+		}
+	}
+	return nil
+}
+
+// MatchMetadata returns true if the metadata satisfies every condition of the filter. An empty filter matches everything.
+func MatchMetadata(filter *api.MetadataFilter, metadata map[string]string) bool {
+	for _, condition := range filter.GetConditions() {
+		if !matchCondition(condition, metadata) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCondition(condition *api.MetadataCondition, metadata map[string]string) bool {
+	value, ok := metadata[condition.GetKey()]
+	switch condition.GetOperator() {
+	case api.MetadataOperator_Equal, api.MetadataOperator_In:
+		return ok && containsValue(condition.GetValues(), value)
+	case api.MetadataOperator_NotEqual, api.MetadataOperator_NotIn:
+		return !ok || !containsValue(condition.GetValues(), value)
+	case api.MetadataOperator_Exists:
+		return ok
+	case api.MetadataOperator_NotExists:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func matchDetail(filter *api.MetadataFilter, detail *api.ObjectDetail) bool {
+	return MatchMetadata(filter, detail.GetObject().GetMetadata())
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
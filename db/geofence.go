@@ -0,0 +1,311 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	// geofencePrefix namespaces stored geofences: geofencePrefix + geofence key
+	geofencePrefix = reservedPrefix + "geofence_"
+	// geofenceIndexPrefix namespaces the spatial index of geofences: geofenceIndexPrefix + geohash cell + "_" + geofence key for every cell
+	// covering the geofences bound. The cells of a geofence share a precision, the cells of different geofences may not.
+	geofenceIndexPrefix = reservedPrefix + "geofencecell_"
+	geofenceIndexMarker = reservedPrefix + "meta_geofence_index"
+)
+
+func geofenceIndexKey(cell, key string) []byte {
+	return []byte(geofenceIndexPrefix + cell + "_" + key)
+}
+
+// geofenceCells returns the geohash cells covering the geofence
+func geofenceCells(fence *api.Geofence) []string {
+	return coveringGeohashes(areaBound(fence.Circle, fence.Polygon))
+}
+
+// indexGeofence replaces the spatial index entries of the previous version of the geofence(nil if it is new) with the ones of fence
+// inside the given write transaction
+func indexGeofence(txn *badger.Txn, previous *api.Geofence, fence *api.Geofence) error {
+	if err := unindexGeofence(txn, previous); err != nil {
+		return err
+	}
+	for _, cell := range geofenceCells(fence) {
+		if err := txn.SetEntry(&badger.Entry{
+			Key:      geofenceIndexKey(cell, fence.Key),
+			UserMeta: geofenceIndexMeta,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unindexGeofence(txn *badger.Txn, fence *api.Geofence) error {
+	if fence == nil {
+		return nil
+	}
+	for _, cell := range geofenceCells(fence) {
+		if err := txn.Delete(geofenceIndexKey(cell, fence.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pointGeofences adds the geofences whose cells contain the point to fences. A cell contains the point if it prefixes the points
+// geohash, so the index is searched once per precision.
+func pointGeofences(txn *badger.Txn, point *api.Point, fences map[string]*api.Geofence) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	hash := geo.NewPointFromLatLng(point.Lat, point.Lon).GeoHash(geohashPrecision)
+	var keys []string
+	for precision := 1; precision <= geohashPrecision; precision++ {
+		prefix := []byte(geofenceIndexPrefix + hash[:precision] + "_")
+		for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+			if iter.Item().UserMeta() != geofenceIndexMeta {
+				continue
+			}
+			keys = append(keys, strings.TrimPrefix(string(iter.Item().Key()), string(prefix)))
+		}
+	}
+	for _, key := range keys {
+		if _, ok := fences[key]; ok {
+			continue
+		}
+		fence, err := getGeofence(txn, key)
+		if err != nil {
+			return err
+		}
+		if fence != nil {
+			fences[key] = fence
+		}
+	}
+	return nil
+}
+
+func validateGeofence(fence *api.Geofence) error {
+	if err := fence.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return ValidateArea(fence.Circle, fence.Polygon)
+}
+
+// geofenceContains returns true if the point is inside the geofences circle or polygon
+func geofenceContains(fence *api.Geofence, point *api.Point) bool {
+	return AreaContains(fence.Circle, fence.Polygon, point)
+}
+
+func SetGeofence(db *badger.DB, fence *api.Geofence) (*api.Geofence, error) {
+	if err := validateGeofence(fence); err != nil {
+		return nil, err
+	}
+	bits, err := proto.Marshal(fence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal geofence: %s", err.Error())
+	}
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	previous, err := getGeofence(txn, fence.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get geofence: %s", err.Error())
+	}
+	if err := indexGeofence(txn, previous, fence); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to index geofence: %s", err.Error())
+	}
+	if err := txn.SetEntry(&badger.Entry{
+		Key:      []byte(geofencePrefix + fence.Key),
+		Value:    bits,
+		UserMeta: geofenceMeta,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set geofence: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set geofence: %s", err.Error())
+	}
+	return fence, nil
+}
+
+// GetGeofences returns the geofences stored at keys, or every geofence if zero keys are present
+func GetGeofences(db *badger.DB, keys []string) (map[string]*api.Geofence, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	if len(keys) == 0 {
+		fences, err := getGeofences(txn)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get geofences: %s", err.Error())
+		}
+		return fences, nil
+	}
+	fences := map[string]*api.Geofence{}
+	for _, key := range keys {
+		fence, err := getGeofence(txn, key)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get geofence: %s %s", key, err.Error())
+		}
+		if fence == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to get geofence: %s %s", key, badger.ErrKeyNotFound.Error())
+		}
+		fences[key] = fence
+	}
+	return fences, nil
+}
+
+// getGeofence returns the geofence stored at key or nil if it doesn't exist
+func getGeofence(txn *badger.Txn, key string) (*api.Geofence, error) {
+	item, err := txn.Get([]byte(geofencePrefix + key))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	var fence = &api.Geofence{}
+	if err := proto.Unmarshal(res, fence); err != nil {
+		return nil, err
+	}
+	return fence, nil
+}
+
+func DeleteGeofences(db *badger.DB, keys []string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	for _, key := range keys {
+		fence, err := getGeofence(txn, key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get geofence: %s %s", key, err.Error())
+		}
+		if err := unindexGeofence(txn, fence); err != nil {
+			return status.Errorf(codes.Internal, "failed to unindex geofence: %s %s", key, err.Error())
+		}
+		if err := txn.Delete([]byte(geofencePrefix + key)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete geofence: %s %s", key, err.Error())
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete geofences %s", err.Error())
+	}
+	return nil
+}
+
+func getGeofences(txn *badger.Txn) (map[string]*api.Geofence, error) {
+	fences := map[string]*api.Geofence{}
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(geofencePrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != geofenceMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, err
+		}
+		var fence = &api.Geofence{}
+		if err := proto.Unmarshal(res, fence); err != nil {
+			return nil, err
+		}
+		fences[fence.Key] = fence
+	}
+	return fences, nil
+}
+
+// evaluateGeofences compares the objects previous position(nil if it is new) and its current position against the geofences whose cells
+// contain either position, recording how long the object has been inside each geofence inside the given write transaction
+func evaluateGeofences(txn *badger.Txn, previous *api.Object, obj *api.Object) ([]*api.GeofenceEvent, error) {
+	fences := map[string]*api.Geofence{}
+	for _, object := range []*api.Object{previous, obj} {
+		if object == nil || object.Point == nil {
+			continue
+		}
+		if err := pointGeofences(txn, object.Point, fences); err != nil {
+			return nil, err
+		}
+	}
+	var events []*api.GeofenceEvent
+	for _, fence := range fences {
+		wasInside := previous != nil && previous.Point != nil && geofenceContains(fence, previous.Point)
+		isInside := geofenceContains(fence, obj.Point)
+		var transition api.GeofenceTransition
+		switch {
+		case !wasInside && isInside:
+			transition = api.GeofenceTransition_Enter
+		case wasInside && !isInside:
+			transition = api.GeofenceTransition_Exit
+		case wasInside && isInside:
+			transition = api.GeofenceTransition_Inside
+		default:
+			continue
+		}
+		seconds, _, crossed, err := updateDwell(txn, dwellGeofence, obj.Key, fence.Key, isInside, obj.UpdatedUnix, dwellThreshold(fence.DwellSeconds))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &api.GeofenceEvent{
+			Geofence:      fence,
+			Object:        obj,
+			Transition:    transition,
+			TimestampUnix: obj.UpdatedUnix,
+			DwellSeconds:  seconds,
+		})
+		if crossed {
+			events = append(events, &api.GeofenceEvent{
+				Geofence:      fence,
+				Object:        obj,
+				Transition:    api.GeofenceTransition_Dwell,
+				TimestampUnix: obj.UpdatedUnix,
+				DwellSeconds:  seconds,
+			})
+		}
+	}
+	return events, nil
+}
+
+// RebuildGeofenceIndex writes the spatial index entries of every stored geofence. It runs once against databases created before the index existed.
+func RebuildGeofenceIndex(db *badger.DB) error {
+	txn := db.NewTransaction(false)
+	_, err := txn.Get([]byte(geofenceIndexMarker))
+	txn.Discard()
+	if err == nil {
+		return nil
+	}
+	if err != badger.ErrKeyNotFound {
+		return err
+	}
+	txn = db.NewTransaction(false)
+	defer txn.Discard()
+	fences, err := getGeofences(txn)
+	if err != nil {
+		return err
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, fence := range fences {
+		for _, cell := range geofenceCells(fence) {
+			if err := wb.SetEntry(&badger.Entry{
+				Key:      geofenceIndexKey(cell, fence.Key),
+				UserMeta: geofenceIndexMeta,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := wb.SetEntry(&badger.Entry{
+		Key:      []byte(geofenceIndexMarker),
+		UserMeta: geofenceIndexMeta,
+	}); err != nil {
+		return err
+	}
+	return wb.Flush()
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// distance returns the haversine distance in meters between two points
+func distance(a, b *api.Point) float64 {
+	return geo.NewPointFromLatLng(a.Lat, a.Lon).GeoDistanceFrom(geo.NewPointFromLatLng(b.Lat, b.Lon), true)
+}
+
+func validatePolygon(polygon *api.Polygon) error {
+	if polygon == nil || len(polygon.Rings) == 0 {
+		return status.Error(codes.InvalidArgument, "polygon requires an exterior ring")
+	}
+	for _, ring := range polygon.Rings {
+		if ring == nil || len(ring.Points) < 3 {
+			return status.Error(codes.InvalidArgument, "polygon rings require at least 3 points")
+		}
+		for _, point := range ring.Points {
+			if point == nil {
+				return status.Error(codes.InvalidArgument, "polygon rings may not contain empty points")
+			}
+		}
+	}
+	return nil
+}
+
+// polygonBound returns the bounding box of the polygons exterior ring
+func polygonBound(polygon *api.Polygon) *geo.Bound {
+	exterior := polygon.Rings[0].Points
+	bound := geo.NewBoundFromPoints(
+		geo.NewPointFromLatLng(exterior[0].Lat, exterior[0].Lon),
+		geo.NewPointFromLatLng(exterior[0].Lat, exterior[0].Lon),
+	)
+	for _, point := range exterior[1:] {
+		bound.Extend(geo.NewPointFromLatLng(point.Lat, point.Lon))
+	}
+	return bound
+}
+
+// polygonContains returns true if the point is inside the polygons exterior ring and outside all of its holes
+func polygonContains(polygon *api.Polygon, point *geo.Point) bool {
+	if !ringContains(polygon.Rings[0], point) {
+		return false
+	}
+	for _, hole := range polygon.Rings[1:] {
+		if ringContains(hole, point) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringContains uses the even-odd ray casting rule to determine whether the point is inside the ring
+func ringContains(ring *api.Ring, point *geo.Point) bool {
+	inside := false
+	points := ring.Points
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		xi, yi := points[i].Lon, points[i].Lat
+		xj, yj := points[j].Lon, points[j].Lat
+		if (yi > point.Lat()) != (yj > point.Lat()) && point.Lng() < (xj-xi)*(point.Lat()-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// boxBound returns the bound of the box. A south west corner east of the north east corner crosses the antimeridian, the bound keeps
+// west > east then like the bounds coveringGeohashes walks. A south west corner north of the north east corner is an error.
+func boxBound(box *api.Box) (*geo.Bound, error) {
+	if box.SouthWest.Lat > box.NorthEast.Lat {
+		return nil, status.Errorf(codes.InvalidArgument, "box south west latitude(%v) is north of its north east latitude(%v)", box.SouthWest.Lat, box.NorthEast.Lat)
+	}
+	bound := geo.NewBound(box.SouthWest.Lon, box.NorthEast.Lon, box.SouthWest.Lat, box.NorthEast.Lat)
+	// NewBound swaps the longitudes of boxes crossing the antimeridian
+	bound.Set(box.SouthWest.Lon, box.NorthEast.Lon, box.SouthWest.Lat, box.NorthEast.Lat)
+	return bound, nil
+}
+
+// boundContains returns true if the point is within the bound, which crosses the antimeridian if west > east
+func boundContains(bound *geo.Bound, point *geo.Point) bool {
+	if point.Lat() < bound.South() || point.Lat() > bound.North() {
+		return false
+	}
+	if bound.West() > bound.East() {
+		return point.Lng() >= bound.West() || point.Lng() <= bound.East()
+	}
+	return point.Lng() >= bound.West() && point.Lng() <= bound.East()
+}
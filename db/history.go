@@ -0,0 +1,102 @@
+package db
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// historyPrefix namespaces location history entries: historyPrefix + base64(object key) + "_" + encoded updated_unix + zero padded
+// version, so updates within the same second are kept apart
+const historyPrefix = reservedPrefix + "history_"
+
+func historyKeyPrefix(key string) string {
+	return historyPrefix + indexEncoding.EncodeToString([]byte(key)) + "_"
+}
+
+// historyUnixPrefix prefixes the history entries of the object stored at key that were updated at updatedUnix. updatedUnix is encoded big
+// endian with its sign bit flipped, so entries sort by updated_unix including negative(pre 1970) timestamps.
+func historyUnixPrefix(key string, updatedUnix int64) string {
+	var unix [8]byte
+	binary.BigEndian.PutUint64(unix[:], uint64(updatedUnix)^(1<<63))
+	return historyKeyPrefix(key) + string(unix[:])
+}
+
+func historyKey(key string, updatedUnix int64, version uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", historyUnixPrefix(key, updatedUnix), version))
+}
+
+// appendHistory stores version of obj as a history entry inside the given write transaction. History entries expire after
+// GEODB_HISTORY_RETENTION, a retention of zero disables history.
+func appendHistory(txn *badger.Txn, obj *api.Object, version uint64) error {
+	retention := config.Config.GetDuration("GEODB_HISTORY_RETENTION")
+	if retention <= 0 {
+		return nil
+	}
+	expiresAt := time.Unix(obj.UpdatedUnix, 0).Add(retention).Unix()
+	// the update is older than the retention already
+	if expiresAt <= 0 {
+		return nil
+	}
+	bits, err := proto.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:       historyKey(obj.Key, obj.UpdatedUnix, version),
+		Value:     bits,
+		UserMeta:  historyMeta,
+		ExpiresAt: uint64(expiresAt),
+	})
+}
+
+// GetHistory returns the positions of the object stored at key that were updated between fromUnix and toUnix(inclusive), oldest first.
+// An empty toUnix means now. If interval(seconds) is greater than zero, at most one position is returned per interval.
+func GetHistory(db *badger.DB, key string, fromUnix, toUnix, interval int64) ([]*api.Object, error) {
+	if toUnix == 0 {
+		toUnix = time.Now().Unix()
+	}
+	if fromUnix > toUnix {
+		return nil, status.Error(codes.InvalidArgument, "from_unix must be before to_unix")
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(historyKeyPrefix(key))
+	// every entry of toUnix sorts before the first one of the following second
+	end := historyUnixPrefix(key, toUnix+1)
+	var (
+		objects []*api.Object
+		last    int64
+	)
+	for iter.Seek([]byte(historyUnixPrefix(key, fromUnix))); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if string(item.Key()) >= end {
+			break
+		}
+		if item.UserMeta() != historyMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var obj = &api.Object{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if interval > 0 && len(objects) > 0 && obj.UpdatedUnix-last < interval {
+			continue
+		}
+		last = obj.UpdatedUnix
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// reservedPrefix is the key namespace geodb uses for its own bookkeeping. objects may not be stored under it.
+	reservedPrefix = "_geodb_"
+	geohashPrefix  = reservedPrefix + "geohash_"
+	geohashMarker  = reservedPrefix + "meta_geohash_index"
+	// geohashPrecision is the length of the geohash stored in each spatial index entry
+	geohashPrecision = 12
+	// maxCoveringCells caps the number of geohash cells walked for a single boundary scan
+	maxCoveringCells = 64
+)
+
+func geohashIndexKey(hash string, key string) []byte {
+	return []byte(geohashPrefix + hash + "_" + key)
+}
+
+func objectGeohash(obj *api.Object) string {
+	return geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon).GeoHash(geohashPrecision)
+}
+
+// indexGeohash updates the spatial index entry of obj inside the given write transaction, removing the entry of the objects previous position if it moved cells.
+func indexGeohash(txn *badger.Txn, obj *api.Object) error {
+	hash := objectGeohash(obj)
+	previous, err := getObjectDetail(txn, obj.Key)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.Object != nil && previous.Object.Point != nil {
+		if prevHash := objectGeohash(previous.Object); prevHash != hash {
+			if err := txn.Delete(geohashIndexKey(prevHash, obj.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	return txn.SetEntry(&badger.Entry{
+		Key:       geohashIndexKey(hash, obj.Key),
+		UserMeta:  geohashMeta,
+		ExpiresAt: uint64(obj.ExpiresUnix),
+	})
+}
+
+// unindexGeohash removes the spatial index entry of the stored version of an object inside the given write transaction
+func unindexGeohash(txn *badger.Txn, previous *api.Object) error {
+	if previous == nil || previous.Point == nil {
+		return nil
+	}
+	return txn.Delete(geohashIndexKey(objectGeohash(previous), previous.Key))
+}
+
+// getObjectDetail returns the object detail stored at key or nil if it doesn't exist
+func getObjectDetail(txn *badger.Txn, key string) (*api.ObjectDetail, error) {
+	item, err := txn.Get([]byte(key))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if item.UserMeta() != objectMeta {
+		return nil, nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	var obj = &api.ObjectDetail{}
+	if err := proto.Unmarshal(res, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// scanGeohash walks the spatial index entries of every geohash cell covering the boundary in index key order, starting after the
+// cursor(optional), and calls fn once for each indexed object with its index key. fn is responsible for the exact containment check.
+func scanGeohash(txn *badger.Txn, bound *geo.Bound, after []byte, fn func(cursor []byte, key string, obj *api.ObjectDetail) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	seen := map[string]struct{}{}
+	cells := coveringGeohashes(bound)
+	// the cells share a precision, so walking them sorted walks the index keys in order
+	sort.Strings(cells)
+	for _, cell := range cells {
+		prefix := []byte(geohashPrefix + cell)
+		for seekAfter(iter, prefix, after); iter.ValidForPrefix(prefix); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != geohashMeta {
+				continue
+			}
+			key := string(item.Key())[len(geohashPrefix)+geohashPrecision+1:]
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			obj, err := getObjectDetail(txn, key)
+			if err != nil {
+				return status.Errorf(codes.Internal, "%s failed to get indexed object: %s", key, err.Error())
+			}
+			if obj == nil || obj.Object == nil || obj.Object.Point == nil {
+				continue
+			}
+			if err := fn(item.KeyCopy(nil), key, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// coveringGeohashes returns the geohash cells that cover the boundary, using the finest precision that keeps the number of cells under maxCoveringCells
+func coveringGeohashes(bound *geo.Bound) []string {
+	south, north := math.Max(bound.South(), -90), math.Min(bound.North(), 90)
+	spans := [][2]float64{{bound.West(), bound.East()}}
+	if bound.West() > bound.East() {
+		// the boundary crosses the antimeridian
+		spans = [][2]float64{{bound.West(), 180}, {-180, bound.East()}}
+	}
+	precision := geohashPrecision
+	for ; precision > 1; precision-- {
+		width, height := geohashCellSize(precision)
+		cells := 0.0
+		for _, span := range spans {
+			cells += (math.Floor((span[1]-span[0])/width) + 2) * (math.Floor((north-south)/height) + 2)
+		}
+		if cells <= maxCoveringCells {
+			break
+		}
+	}
+	width, height := geohashCellSize(precision)
+	var hashes []string
+	seen := map[string]struct{}{}
+	add := func(lat, lon float64) {
+		hash := geo.NewPointFromLatLng(lat, lon).GeoHash(precision)
+		if _, ok := seen[hash]; !ok {
+			seen[hash] = struct{}{}
+			hashes = append(hashes, hash)
+		}
+	}
+	for _, span := range spans {
+		for lat := south; ; lat += height {
+			lat = math.Min(lat, north)
+			for lon := span[0]; ; lon += width {
+				lon = math.Min(lon, span[1])
+				add(lat, lon)
+				if lon >= span[1] {
+					break
+				}
+			}
+			if lat >= north {
+				break
+			}
+		}
+	}
+	return hashes
+}
+
+// geohashCellSize returns the width and height in degrees of a geohash cell of the given precision
+func geohashCellSize(precision int) (float64, float64) {
+	bits := 5 * precision
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 360 / math.Pow(2, float64(lonBits)), 180 / math.Pow(2, float64(latBits))
+}
+
+// RebuildGeohashIndex writes a spatial index entry for every stored object. It runs once against databases created before the index existed.
+func RebuildGeohashIndex(db *badger.DB) error {
+	txn := db.NewTransaction(false)
+	_, err := txn.Get([]byte(geohashMarker))
+	txn.Discard()
+	if err == nil {
+		return nil
+	}
+	if err != badger.ErrKeyNotFound {
+		return err
+	}
+	txn = db.NewTransaction(false)
+	defer txn.Discard()
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != objectMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return err
+		}
+		if obj.Object == nil || obj.Object.Point == nil {
+			continue
+		}
+		if err := wb.SetEntry(&badger.Entry{
+			Key:       geohashIndexKey(objectGeohash(obj.Object), string(item.Key())),
+			UserMeta:  geohashMeta,
+			ExpiresAt: item.ExpiresAt(),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := wb.SetEntry(&badger.Entry{
+		Key:      []byte(geohashMarker),
+		UserMeta: geohashMeta,
+	}); err != nil {
+		return err
+	}
+	return wb.Flush()
+}
+
+func isReservedKey(key string) bool {
+	return strings.HasPrefix(key, reservedPrefix)
+}
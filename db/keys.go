@@ -7,62 +7,55 @@ import (
 	"regexp"
 )
 
-func GetKeys(db *badger.DB) []string {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	keys := []string{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		keys = append(keys, string(item.Key()))
-	}
-	iter.Close()
-	return keys
+// GetKeys returns up to pageSize(zero returns every key) object keys in order, starting after the page token(optional), and the page
+// token of the next page
+func GetKeys(db *badger.DB, pageSize int32, pageToken string) ([]string, string, error) {
+	return listKeys(db, nil, nil, pageSize, pageToken)
 }
 
-func GetPrefixKeys(db *badger.DB, prefix string) []string {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	keys := []string{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		keys = append(keys, string(item.Key()))
+func GetPrefixKeys(db *badger.DB, prefix string, pageSize int32, pageToken string) ([]string, string, error) {
+	return listKeys(db, []byte(prefix), nil, pageSize, pageToken)
+}
+
+func GetRegexKeys(db *badger.DB, regex string, pageSize int32, pageToken string) ([]string, string, error) {
+	rx, err := regexp.Compile(regex)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
 	}
-	iter.Close()
-	return keys
+	return listKeys(db, nil, rx.MatchString, pageSize, pageToken)
 }
 
-func GetRegexKeys(db *badger.DB, regex string) ([]string, error) {
+// listKeys pages through the object keys that start with the prefix and are accepted by match(optional)
+func listKeys(db *badger.DB, prefix []byte, match func(key string) bool, pageSize int32, pageToken string) ([]string, string, error) {
+	cursor, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	after, err := cursor.after(keyCursor)
+	if err != nil {
+		return nil, "", err
+	}
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
 	keys := []string{}
 	opts := badger.DefaultIteratorOptions
 	opts.PrefetchValues = false
 	iter := txn.NewIterator(opts)
-	for iter.Rewind(); iter.Valid(); iter.Next() {
+	defer iter.Close()
+	for seekAfter(iter, prefix, after); iter.ValidForPrefix(prefix); iter.Next() {
 		item := iter.Item()
-		if item.UserMeta() != 1 {
+		if item.UserMeta() != objectMeta {
 			continue
 		}
-		match, err := regexp.MatchString(string(regex), string(item.Key()))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+		key := string(item.Key())
+		if match != nil && !match(key) {
+			continue
 		}
-		if match {
-			keys = append(keys, string(item.Key()))
+		if pageSize > 0 && len(keys) == int(pageSize) {
+			// there is at least one more key
+			return keys, encodePageToken(pageCursor{kind: keyCursor, key: []byte(keys[len(keys)-1])}), nil
 		}
+		keys = append(keys, key)
 	}
-	iter.Close()
-	return keys, nil
+	return keys, "", nil
 }
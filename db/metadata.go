@@ -0,0 +1,355 @@
+package db
+
+import (
+	"encoding/base64"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	geo "github.com/paulmach/go.geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// metadataIndexDeclPrefix namespaces the declarations of the metadata keys that are indexed
+	metadataIndexDeclPrefix = reservedPrefix + "meta_metadata_index_"
+	// metadataIndexPrefix namespaces the inverted index entries: metadataIndexPrefix + base64(key) + "_" + base64(value) + "_" + object key
+	metadataIndexPrefix = reservedPrefix + "metadata_"
+	// metadataIndexGeneration is written whenever a metadata index is declared or removed and read by every object write, so writes that
+	// didn't see a declaration conflict with it instead of committing without their index entries
+	metadataIndexGeneration = reservedPrefix + "meta_metadata_generation"
+	// metadataRebuildBatch is the number of objects indexed per transaction by RebuildMetadataIndex
+	metadataRebuildBatch = 500
+	// metadataRebuildAttempts is the number of times a batch that conflicts with concurrent writes is tried before the rebuild fails
+	metadataRebuildAttempts = 10
+	// metadataRebuildBackoff is the wait before the first retry of a conflicting batch, it doubles with every retry
+	metadataRebuildBackoff = 10 * time.Millisecond
+)
+
+var indexEncoding = base64.RawStdEncoding
+
+func metadataIndexValuePrefix(key, value string) string {
+	return metadataIndexPrefix + indexEncoding.EncodeToString([]byte(key)) + "_" + indexEncoding.EncodeToString([]byte(value)) + "_"
+}
+
+func metadataIndexKey(key, value, objectKey string) []byte {
+	return []byte(metadataIndexValuePrefix(key, value) + objectKey)
+}
+
+// indexedMetadataKeys returns the declared metadata indexes as seen by the transaction
+func indexedMetadataKeys(txn *badger.Txn) []string {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var keys []string
+	prefix := []byte(metadataIndexDeclPrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		keys = append(keys, strings.TrimPrefix(string(iter.Item().Key()), metadataIndexDeclPrefix))
+	}
+	return keys
+}
+
+// watchMetadataIndexes reads the metadata index generation so the write transaction conflicts with declarations committed after it began.
+// badger only detects conflicts on keys that were read, and a declaration that didn't exist yet was never read by listing them.
+func watchMetadataIndexes(txn *badger.Txn) error {
+	if _, err := txn.Get([]byte(metadataIndexGeneration)); err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	return nil
+}
+
+// bumpMetadataIndexes writes the metadata index generation inside the transaction declaring or removing an index
+func bumpMetadataIndexes(txn *badger.Txn) error {
+	return txn.SetEntry(&badger.Entry{
+		Key:      []byte(metadataIndexGeneration),
+		UserMeta: metadataIndexMeta,
+	})
+}
+
+// indexMetadata updates the inverted index entries of obj inside the given write transaction, removing the entries of its previous metadata
+func indexMetadata(txn *badger.Txn, obj *api.Object) error {
+	if err := watchMetadataIndexes(txn); err != nil {
+		return err
+	}
+	indexed := indexedMetadataKeys(txn)
+	if len(indexed) == 0 {
+		return nil
+	}
+	previous, err := getObjectDetail(txn, obj.Key)
+	if err != nil {
+		return err
+	}
+	for _, key := range indexed {
+		if previous != nil {
+			// the previous entry is removed whenever the value changes, including to or from an empty value, or the key is removed
+			value, ok := previous.GetObject().GetMetadata()[key]
+			current, present := obj.Metadata[key]
+			if ok && (!present || current != value) {
+				if err := txn.Delete(metadataIndexKey(key, value, obj.Key)); err != nil {
+					return err
+				}
+			}
+		}
+		if value, ok := obj.Metadata[key]; ok {
+			if err := txn.SetEntry(&badger.Entry{
+				Key:       metadataIndexKey(key, value, obj.Key),
+				UserMeta:  metadataIndexMeta,
+				ExpiresAt: uint64(obj.ExpiresUnix),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unindexMetadata removes the inverted index entries of the stored version of an object inside the given write transaction
+func unindexMetadata(txn *badger.Txn, previous *api.Object) error {
+	if err := watchMetadataIndexes(txn); err != nil {
+		return err
+	}
+	indexed := indexedMetadataKeys(txn)
+	if len(indexed) == 0 {
+		return nil
+	}
+	if previous == nil {
+		return nil
+	}
+	for _, metaKey := range indexed {
+		if value, ok := previous.GetMetadata()[metaKey]; ok {
+			if err := txn.Delete(metadataIndexKey(metaKey, value, previous.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexedCandidates returns the keys of the objects that may match the filter according to the metadata indexes.
+// ok is false if no condition of the filter is an Equal/In condition on an indexed key, in which case the caller must fall back to a scan.
+// The candidates of every usable condition are intersected. Candidates must still be checked against the full filter.
+func indexedCandidates(txn *badger.Txn, filter *api.MetadataFilter) ([]string, bool) {
+	if len(filter.GetConditions()) == 0 {
+		return nil, false
+	}
+	indexed := map[string]struct{}{}
+	for _, key := range indexedMetadataKeys(txn) {
+		indexed[key] = struct{}{}
+	}
+	var candidates map[string]struct{}
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	for _, condition := range filter.GetConditions() {
+		if condition.GetOperator() != api.MetadataOperator_Equal && condition.GetOperator() != api.MetadataOperator_In {
+			continue
+		}
+		if _, ok := indexed[condition.GetKey()]; !ok {
+			continue
+		}
+		matches := map[string]struct{}{}
+		for _, value := range condition.GetValues() {
+			prefix := metadataIndexValuePrefix(condition.GetKey(), value)
+			for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
+				if iter.Item().UserMeta() != metadataIndexMeta {
+					continue
+				}
+				key := strings.TrimPrefix(string(iter.Item().Key()), prefix)
+				if candidates == nil {
+					matches[key] = struct{}{}
+				} else if _, ok := candidates[key]; ok {
+					matches[key] = struct{}{}
+				}
+			}
+		}
+		candidates = matches
+	}
+	if candidates == nil {
+		return nil, false
+	}
+	keys := make([]string, 0, len(candidates))
+	for key := range candidates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, true
+}
+
+// GetMetadataIndexes returns the metadata keys that are indexed
+func GetMetadataIndexes(db *badger.DB) []string {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	return indexedMetadataKeys(txn)
+}
+
+// AddMetadataIndex declares key as an indexed metadata key and builds its index entries for every stored object.
+// It is a no-op if the key is already indexed.
+func AddMetadataIndex(db *badger.DB, key string) error {
+	if key == "" {
+		return status.Error(codes.InvalidArgument, "empty metadata index key")
+	}
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	if _, err := txn.Get([]byte(metadataIndexDeclPrefix + key)); err == nil {
+		return nil
+	} else if err != badger.ErrKeyNotFound {
+		return status.Errorf(codes.Internal, "failed to get metadata index: %s", err.Error())
+	}
+	if err := txn.SetEntry(&badger.Entry{
+		Key:      []byte(metadataIndexDeclPrefix + key),
+		UserMeta: metadataIndexMeta,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to declare metadata index: %s", err.Error())
+	}
+	if err := bumpMetadataIndexes(txn); err != nil {
+		return status.Errorf(codes.Internal, "failed to declare metadata index: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to declare metadata index: %s", err.Error())
+	}
+	if err := RebuildMetadataIndex(db, key); err != nil {
+		// an incomplete index would hide the objects it misses from filters
+		if removeErr := RemoveMetadataIndex(db, key); removeErr != nil {
+			return removeErr
+		}
+		if err == badger.ErrConflict {
+			return status.Errorf(codes.Aborted, "failed to build metadata index: %s", err.Error())
+		}
+		return status.Errorf(codes.Internal, "failed to build metadata index: %s", err.Error())
+	}
+	return nil
+}
+
+// RebuildMetadataIndex writes the index entries of key for every stored object. Writes that began before the index was declared conflict
+// with the declaration(see watchMetadataIndexes) and writes that began after it index their objects themselves. Objects are indexed in
+// batches of write transactions that read them again, so an object changed while its batch runs makes the batch conflict and retry
+// rather than leaving an entry for a stale value behind. A batch is tried metadataRebuildAttempts times with an exponential backoff.
+func RebuildMetadataIndex(db *badger.DB, key string) error {
+	txn := db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	var objects []string
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		if iter.Item().UserMeta() == objectMeta {
+			objects = append(objects, string(iter.Item().KeyCopy(nil)))
+		}
+	}
+	iter.Close()
+	txn.Discard()
+	for start := 0; start < len(objects); start += metadataRebuildBatch {
+		end := start + metadataRebuildBatch
+		if end > len(objects) {
+			end = len(objects)
+		}
+		backoff := metadataRebuildBackoff
+		for attempt := 1; ; attempt++ {
+			err := rebuildMetadataBatch(db, key, objects[start:end])
+			if err == nil {
+				break
+			}
+			if err != badger.ErrConflict || attempt == metadataRebuildAttempts {
+				return err
+			}
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return nil
+}
+
+func rebuildMetadataBatch(db *badger.DB, key string, objects []string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	for _, objectKey := range objects {
+		obj, err := getObjectDetail(txn, objectKey)
+		if err != nil {
+			return err
+		}
+		value, ok := obj.GetObject().GetMetadata()[key]
+		if !ok {
+			continue
+		}
+		if err := txn.SetEntry(&badger.Entry{
+			Key:       metadataIndexKey(key, value, objectKey),
+			UserMeta:  metadataIndexMeta,
+			ExpiresAt: uint64(obj.Object.ExpiresUnix),
+		}); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+// RemoveMetadataIndex removes the declaration and every index entry of key
+func RemoveMetadataIndex(db *badger.DB, key string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	if err := txn.Delete([]byte(metadataIndexDeclPrefix + key)); err != nil {
+		return status.Errorf(codes.Internal, "failed to remove metadata index: %s", err.Error())
+	}
+	if err := bumpMetadataIndexes(txn); err != nil {
+		return status.Errorf(codes.Internal, "failed to remove metadata index: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to remove metadata index: %s", err.Error())
+	}
+	rtxn := db.NewTransaction(false)
+	defer rtxn.Discard()
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := rtxn.NewIterator(opts)
+	defer iter.Close()
+	prefix := []byte(metadataIndexPrefix + indexEncoding.EncodeToString([]byte(key)) + "_")
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		if err := wb.Delete(iter.Item().KeyCopy(nil)); err != nil {
+			return status.Errorf(codes.Internal, "failed to remove metadata index entry: %s", err.Error())
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to remove metadata index entries: %s", err.Error())
+	}
+	return nil
+}
+
+// planScan calls fn for every object that may be inside the boundary, starting after the cursor(optional). If the filter can use a metadata
+// index the indexed candidates are walked in key order, otherwise the geohash cells covering the boundary are walked in index key order.
+// Cursors of the other walk are rejected.
+func planScan(txn *badger.Txn, bound *geo.Bound, filter *api.MetadataFilter, cursor pageCursor, fn func(cursor pageCursor, key string, obj *api.ObjectDetail) error) error {
+	keys, ok := indexedCandidates(txn, filter)
+	if !ok {
+		after, err := cursor.after(geohashCursor)
+		if err != nil {
+			return err
+		}
+		return scanGeohash(txn, bound, after, func(indexKey []byte, key string, obj *api.ObjectDetail) error {
+			return fn(pageCursor{kind: geohashCursor, key: indexKey}, key, obj)
+		})
+	}
+	after, err := cursor.after(keyCursor)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if after != nil && key <= string(after) {
+			continue
+		}
+		obj, err := getObjectDetail(txn, key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%s failed to get indexed object: %s", key, err.Error())
+		}
+		if obj == nil || obj.Object == nil || obj.Object.Point == nil {
+			continue
+		}
+		if err := fn(pageCursor{kind: keyCursor, key: []byte(key)}, key, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
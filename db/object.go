@@ -1,7 +1,9 @@
 package db
 
 import (
-	"context"
+	"errors"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
 	"github.com/autom8ter/geodb/maps"
@@ -9,24 +11,167 @@ import (
 	"github.com/autom8ter/geodb/stream"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/gogo/protobuf/proto"
-	geo "github.com/paulmach/go.geo"
 	log "github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
-func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*api.ObjectDetail, error) {
+const (
+	objectMeta        = 1
+	geohashMeta       = 6
+	metadataIndexMeta = 7
+	historyMeta       = 8
+	geofenceMeta      = 9
+	dwellMeta         = 10
+	trackerIndexMeta  = 11
+	webhookMeta       = 12
+	deadLetterMeta    = 13
+	changeLogMeta     = 14
+	expiryMeta        = 15
+	// 16 is taken by the maps budget
+	geofenceIndexMeta = 17
+)
+
+// objectWrite is a prepared object detail waiting to be written along with the precondition of the write
+type objectWrite struct {
+	detail       *api.ObjectDetail
+	precondition *api.Precondition
+	// skipped is set if the object was stale and GEODB_STALE_WRITE_POLICY is skip, current holds the stored object detail then
+	skipped bool
+	current *api.ObjectDetail
+	// enrich is set if the maps lookups of the object are deferred until after it is committed(GEODB_ENRICHMENT_MODE async)
+	enrich bool
+	// independent writes that fail their precondition are left out of the transaction instead of failing it, failed holds the error then
+	independent bool
+	failed      error
+}
+
+// response returns the stored object detail after the write
+func (w *objectWrite) response() *api.SetResponse {
+	if w.skipped {
+		return &api.SetResponse{
+			Object:  w.current,
+			Skipped: true,
+		}
+	}
+	return &api.SetResponse{
+		Object: w.detail,
+	}
+}
+
+// Set sets the object if the stored object meets the precondition(optional). Stale objects are rejected with a FailedPrecondition error
+// or skipped depending on GEODB_STALE_WRITE_POLICY, the response holds the stored object detail and skipped is set in the latter case.
+func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object, precondition *api.Precondition) (*api.SetResponse, error) {
+	write, err := prepareObject(db, maps, obj)
+	if err != nil {
+		return nil, err
+	}
+	write.precondition = precondition
+	if err := commitObjects(db, maps, hub, []*objectWrite{write}); err != nil {
+		return nil, err
+	}
+	return write.response(), nil
+}
+
+// BatchSet sets the objects in one write transaction: either every object is set or none of them are. The details are published in order
+// once the transaction is committed.
+func BatchSet(db *badger.DB, maps *maps.Client, hub *stream.Hub, objs []*api.Object) ([]*api.ObjectDetail, error) {
+	writes := make([]*objectWrite, len(objs))
+	for i, obj := range objs {
+		write, err := prepareObject(db, maps, obj)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "object %d: %s", i, status.Convert(err).Message())
+		}
+		writes[i] = write
+	}
+	if err := commitObjects(db, maps, hub, writes); err != nil {
+		return nil, err
+	}
+	details := make([]*api.ObjectDetail, len(writes))
+	for i, write := range writes {
+		details[i] = write.detail
+	}
+	return details, nil
+}
+
+// SetMany sets the objects in one write transaction and returns the response or the error of every request in order. Objects succeed or fail
+// on their own: invalid objects and objects failing their precondition are left out of the transaction, and if the batched transaction
+// fails anyway, the remaining objects are set one by one.
+func SetMany(db *badger.DB, maps *maps.Client, hub *stream.Hub, requests []*api.SetRequest) ([]*api.SetResponse, []error) {
+	writes := make([]*objectWrite, len(requests))
+	errs := make([]error, len(requests))
+	var prepared []*objectWrite
+	for i, r := range requests {
+		write, err := prepareObject(db, maps, r.Object)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		write.precondition = r.Precondition
+		write.independent = true
+		writes[i] = write
+		prepared = append(prepared, writes[i])
+	}
+	if len(prepared) > 0 {
+		if err := commitObjects(db, maps, hub, prepared); err != nil {
+			log.Warnf("failed to set %d objects in one transaction, setting them one by one: %s", len(prepared), err.Error())
+			for i, write := range writes {
+				if write == nil {
+					continue
+				}
+				write.skipped = false
+				write.failed = nil
+				if errs[i] = commitObjects(db, maps, hub, []*objectWrite{write}); errs[i] != nil {
+					writes[i] = nil
+				}
+			}
+		}
+	}
+	for i, write := range writes {
+		if write != nil && write.failed != nil {
+			errs[i] = write.failed
+			writes[i] = nil
+		}
+	}
+	responses := make([]*api.SetResponse, len(requests))
+	for i, write := range writes {
+		if write != nil {
+			responses[i] = write.response()
+		}
+	}
+	return responses, errs
+}
+
+// prepareObject validates the object and builds its detail: the tracker events and the optional address & timezone. In async enrichment
+// mode the maps lookups are left to the enrichment workers.
+func prepareObject(db *badger.DB, maps *maps.Client, obj *api.Object) (*objectWrite, error) {
 	if err := obj.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if isReservedKey(obj.Key) {
+		return nil, status.Errorf(codes.InvalidArgument, "keys with the prefix %s are reserved", reservedPrefix)
+	}
 	if obj.UpdatedUnix == 0 {
 		obj.UpdatedUnix = time.Now().Unix()
 	}
 	metrics.GaugeObjectLocation(obj.Key, obj.Point)
-	point1 := geo.NewPointFromLatLng(obj.Point.Lat, obj.Point.Lon)
+	write := &objectWrite{}
+	if enrichAsync(maps) && needsLookups(obj) {
+		write.enrich = true
+		maps = nil
+	}
+	write.detail = lookupObject(db, maps, obj)
+	return write, nil
+}
+
+// lookupObject builds the detail of the object: the tracker events(with directions) and the optional address & timezone
+func lookupObject(db *badger.DB, maps *maps.Client, obj *api.Object) *api.ObjectDetail {
 	mu := &sync.Mutex{}
 	wg := &sync.WaitGroup{}
 	var events = map[string]*api.TrackerEvent{}
@@ -36,53 +181,19 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 			go func(val *api.Object, tracker *api.ObjectTracker) {
 				defer wg.Done()
 				txn := db.NewTransaction(false)
-
-				item, err := txn.Get([]byte(tracker.GetTargetObjectKey()))
-				if err != nil {
-					return
-				}
-				res, err := item.ValueCopy(nil)
+				defer txn.Discard()
+				target, err := getObjectDetail(txn, tracker.GetTargetObjectKey())
 				if err != nil {
 					log.Error(err.Error())
 					return
 				}
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					log.Error(err.Error())
-					return
-				}
-				if obj.Object.Point == nil {
+				if target == nil || target.Object == nil || target.Object.Point == nil {
 					return
 				}
-				point2 := geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)
-				dist := point1.GeoDistanceFrom(point2, true)
-				trackerEvent := &api.TrackerEvent{
-					Object:        obj.Object,
-					Distance:      dist,
-					Inside:        dist <= float64(val.Radius+obj.Object.Radius),
-					TimestampUnix: val.UpdatedUnix,
-				}
-				if maps != nil && val.Tracking != nil {
-					directions, eta, dist, err := maps.TravelDetail(context.Background(), val.Point, obj.Object.Point, helpers.ToTravelMode(val.GetTracking().GetTravelMode()))
-					if err != nil {
-						log.Error(err.Error())
-					} else {
-						trackerEvent.Direction = &api.Directions{}
-						if tracker.TrackDirections {
-							trackerEvent.Direction.HtmlDirections = directions
-						}
-						if tracker.TrackEta {
-							trackerEvent.Direction.Eta = int64(eta)
-						}
-						if tracker.TrackDistance {
-							trackerEvent.Direction.TravelDist = int64(dist)
-						}
-					}
-				}
+				trackerEvent := evaluateTracker(maps, val, tracker, target.Object, val.UpdatedUnix)
 				mu.Lock()
-				events[obj.Object.Key] = trackerEvent
+				events[target.Object.Key] = trackerEvent
 				mu.Unlock()
-				txn.Discard()
 			}(obj, t)
 		}
 	}
@@ -123,153 +234,440 @@ func Set(db *badger.DB, maps *maps.Client, hub *stream.Hub, obj *api.Object) (*a
 	}
 	if zone != "" {
 		detail.Timezone = zone
+		offset, dst, err := helpers.ZoneOffset(zone, time.Unix(obj.UpdatedUnix, 0))
+		if err != nil {
+			log.Error(err.Error())
+		} else {
+			detail.UtcOffset = offset
+			detail.Dst = dst
+		}
 	}
 	if len(events) > 0 {
 		for _, event := range events {
 			detail.TrackerEvents = append(detail.TrackerEvents, event)
 		}
 	}
+	return detail
+}
 
-	bits, err := proto.Marshal(detail)
+const (
+	// RejectStaleWrites rejects writes that fail their IfNewerThanUpdatedUnix precondition with a FailedPrecondition error
+	RejectStaleWrites = "reject"
+	// SkipStaleWrites acknowledges writes that fail their IfNewerThanUpdatedUnix precondition without storing them
+	SkipStaleWrites = "skip"
+)
+
+// ParseStaleWritePolicy returns an error if the policy isn't one of RejectStaleWrites or SkipStaleWrites
+func ParseStaleWritePolicy(policy string) error {
+	switch policy {
+	case RejectStaleWrites, SkipStaleWrites:
+		return nil
+	default:
+		return fmt.Errorf("unknown stale write policy: %s", policy)
+	}
+}
+
+// errStaleSkipped is returned by checkPrecondition if a stale write must be skipped rather than rejected
+var errStaleSkipped = errors.New("stale write skipped")
+
+// checkPrecondition returns a FailedPrecondition error if the stored object(previous) doesn't meet the precondition. Writes that are not
+// newer than the stored object are skipped instead if GEODB_STALE_WRITE_POLICY is skip.
+func checkPrecondition(precondition *api.Precondition, previous *api.ObjectDetail, obj *api.Object) error {
+	if precondition == nil {
+		return nil
+	}
+	if precondition.ExpectedVersion > 0 && precondition.ExpectedVersion != previous.GetVersion() {
+		return status.Errorf(codes.FailedPrecondition, "%s version conflict: expected version %d, stored version %d", obj.Key, precondition.ExpectedVersion, previous.GetVersion())
+	}
+	if precondition.IfNewerThanUpdatedUnix && previous != nil && obj.UpdatedUnix <= previous.GetObject().GetUpdatedUnix() {
+		if config.Config.GetString("GEODB_STALE_WRITE_POLICY") == SkipStaleWrites {
+			return errStaleSkipped
+		}
+		return status.Errorf(codes.FailedPrecondition, "%s is stale: updated_unix %d is not newer than the stored updated_unix %d", obj.Key, obj.UpdatedUnix, previous.GetObject().GetUpdatedUnix())
+	}
+	return nil
+}
+
+// writeObject checks the precondition of the write against the stored object, then stores the object detail and updates every index, history
+// and dwell entry that depends on it inside the write transaction. It returns the geofence events of the update. The object detail is
+// stored again by storeObject once its sequence is assigned, storing it here already lets later writes of the same key in the transaction
+// see it as their previous object.
+func writeObject(txn *badger.Txn, write *objectWrite) ([]*api.GeofenceEvent, error) {
+	detail := write.detail
+	obj := detail.Object
+	previous, err := getObjectDetail(txn, obj.Key)
 	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get previous object: %s", err.Error())
+	}
+	if err := checkPrecondition(write.precondition, previous, obj); err != nil {
+		if err != errStaleSkipped {
+			return nil, err
+		}
+		write.skipped = true
+		write.current = previous
+		return nil, nil
+	}
+	detail.Version = previous.GetVersion() + 1
+	geofenceEvents, err := evaluateGeofences(txn, previous.GetObject(), obj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate geofences: %s", err.Error())
+	}
+	if err := indexGeohash(txn, obj); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to index object: %s", err.Error())
+	}
+	if err := indexMetadata(txn, obj); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to index object metadata: %s", err.Error())
+	}
+	if err := appendHistory(txn, obj, detail.Version); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store object history: %s", err.Error())
+	}
+	if err := indexTrackers(txn, previous.GetObject(), obj); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to index object trackers: %s", err.Error())
+	}
+	if err := updateTrackerDwell(txn, obj, detail.TrackerEvents); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tracker dwell: %s", err.Error())
+	}
+	if err := storeObject(txn, previous.GetObject(), detail); err != nil {
 		return nil, err
 	}
-	txn := db.NewTransaction(true)
+	return geofenceEvents, nil
+}
+
+// storeObject stores the object detail along with its copy in the expiration index inside the write transaction. Every write of an
+// object detail goes through it so the copy CheckExpirations publishes never goes stale.
+func storeObject(txn *badger.Txn, previous *api.Object, detail *api.ObjectDetail) error {
+	if err := indexExpiry(txn, previous, detail); err != nil {
+		return status.Errorf(codes.Internal, "failed to index object expiration: %s", err.Error())
+	}
+	return putObject(txn, detail)
+}
+
+// putObject stores the object detail inside the write transaction
+func putObject(txn *badger.Txn, detail *api.ObjectDetail) error {
+	bits, err := proto.Marshal(detail)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal object: %s", err.Error())
+	}
 	if err := txn.SetEntry(&badger.Entry{
-		Key:       []byte(obj.Key),
+		Key:       []byte(detail.Object.Key),
 		Value:     bits,
-		UserMeta:  1,
-		ExpiresAt: uint64(obj.ExpiresUnix),
+		UserMeta:  objectMeta,
+		ExpiresAt: uint64(detail.Object.ExpiresUnix),
 	}); err != nil {
-		return nil, err
+		return status.Errorf(codes.Internal, "failed to set object: %s", err.Error())
 	}
-	if err := txn.Commit(); err != nil {
-		return nil, err
-	}
-	hub.PublishObject(detail)
-	return detail, nil
+	return nil
 }
 
-func Get(db *badger.DB, keys []string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
+// commitObjects performs the writes and appends the written object details to the change log in one transaction, then publishes them
+func commitObjects(db *badger.DB, maps *maps.Client, hub *stream.Hub, writes []*objectWrite) error {
+	txn := db.NewTransaction(true)
 	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	if len(keys) == 0 {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer iter.Close()
-		for iter.Rewind(); iter.Valid(); iter.Next() {
-			item := iter.Item()
-			if item.UserMeta() != 1 {
+	var (
+		details        []*api.ObjectDetail
+		geofenceEvents []*api.GeofenceEvent
+	)
+	for _, write := range writes {
+		events, err := writeObject(txn, write)
+		if err != nil {
+			if write.independent && status.Code(err) == codes.FailedPrecondition {
+				write.failed = err
 				continue
 			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			return err
+		}
+		if !write.skipped {
+			details = append(details, write.detail)
+			geofenceEvents = append(geofenceEvents, events...)
+		}
+	}
+	if err := commitPublish(db, txn, hub, details, func() error {
+		for _, detail := range details {
+			if err := storeObject(txn, detail.Object, detail); err != nil {
+				return err
 			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "(keys) %s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
+		}
+		return nil
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit objects: %s", status.Convert(err).Message())
+	}
+	publishSet(db, maps, hub, details, geofenceEvents)
+	for _, write := range writes {
+		if write.enrich && !write.skipped && write.failed == nil {
+			enqueueObjectEnrichment(db, maps, hub, write.detail.Object, write.detail.Version)
+		}
+	}
+	return nil
+}
+
+// publishSet publishes the geofence events of committed object details and refreshes the trackers watching them. In async enrichment mode
+// the refreshes run on the enrichment workers so their maps lookups don't hold up the write.
+func publishSet(db *badger.DB, maps *maps.Client, hub *stream.Hub, details []*api.ObjectDetail, geofenceEvents []*api.GeofenceEvent) {
+	for _, event := range geofenceEvents {
+		hub.PublishGeofenceEvent(event)
+	}
+	for _, detail := range details {
+		if enrichAsync(maps) {
+			enqueueWatcherRefresh(db, maps, hub, detail.Object.Key, detail.Version)
+			continue
+		}
+		refreshWatchers(db, maps, hub, detail.Object.Key, detail.Object)
+	}
+}
+
+// Get calls fn for the objects stored at keys that match the filter, or for every object matching the filter if zero keys are present,
+// in key order starting after the page token(optional)
+func Get(db *badger.DB, keys []string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		return list(db, pageToken, walkKeys(keys, filter, nil), fn)
+	}
+	return list(db, pageToken, walkObjects(nil, nil, filter), fn)
+}
+
+// GetRegex calls fn for the objects with keys matching the regex that match the filter, in key order starting after the page token(optional)
+func GetRegex(db *badger.DB, regex string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
+	rx, err := regexp.Compile(regex)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to compile regex: %s", err.Error())
+	}
+	return list(db, pageToken, walkObjects(nil, rx.MatchString, filter), fn)
+}
+
+// GetPrefix calls fn for the objects with keys starting with the prefix that match the filter, in key order starting after the page token(optional)
+func GetPrefix(db *badger.DB, prefix string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
+	return list(db, pageToken, walkObjects([]byte(prefix), nil, filter), fn)
+}
+
+// walkObjects walks the objects with keys that start with the prefix and are accepted by match(optional) that satisfy the filter, in key order.
+// The candidates of the metadata indexes are walked instead of the entire keyspace if the filter can use an index.
+func walkObjects(prefix []byte, match func(key string) bool, filter *api.MetadataFilter) walkFunc {
+	return func(txn *badger.Txn, cursor pageCursor, fn visitFunc) error {
+		after, err := cursor.after(keyCursor)
+		if err != nil {
+			return err
+		}
+		if keys, ok := indexedCandidates(txn, filter); ok {
+			for _, key := range keys {
+				if (after != nil && key <= string(after)) || !strings.HasPrefix(key, string(prefix)) || (match != nil && !match(key)) {
+					continue
+				}
+				obj, err := getObjectDetail(txn, key)
+				if err != nil {
+					return status.Errorf(codes.Internal, "%s failed to get indexed object: %s", key, err.Error())
+				}
+				if obj == nil || !matchDetail(filter, obj) {
+					continue
+				}
+				if err := fn(pageCursor{kind: keyCursor, key: []byte(key)}, obj); err != nil {
+					return err
 				}
-				objects[string(item.Key())] = obj
 			}
+			return nil
 		}
-	} else {
-		for _, key := range keys {
-			i, err := txn.Get([]byte(key))
-			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "failed to get key: %s", err.Error())
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+		for seekAfter(iter, prefix, after); iter.ValidForPrefix(prefix); iter.Next() {
+			item := iter.Item()
+			if item.UserMeta() != objectMeta {
+				continue
 			}
-			if i.UserMeta() != 1 {
+			if match != nil && !match(string(item.Key())) {
 				continue
 			}
-			res, err := i.ValueCopy(nil)
+			res, err := item.ValueCopy(nil)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+				return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
 			}
 			var obj = &api.ObjectDetail{}
 			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "(all) failed to unmarshal protobuf: %s", err.Error())
+				return status.Errorf(codes.Internal, "%s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
+			}
+			if !matchDetail(filter, obj) {
+				continue
+			}
+			if err := fn(pageCursor{kind: keyCursor, key: item.KeyCopy(nil)}, obj); err != nil {
+				return err
 			}
-			objects[key] = obj
 		}
+		return nil
 	}
-	return objects, nil
 }
 
-func GetRegex(db *badger.DB, regex string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	defer iter.Close()
-	for iter.Rewind(); iter.Valid(); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		match, err := regexp.MatchString(regex, string(item.Key()))
+// walkKeys walks the objects stored at keys that satisfy the filter and are accepted by accept(optional), in key order. Missing keys are an error.
+func walkKeys(keys []string, filter *api.MetadataFilter, accept func(obj *api.ObjectDetail) bool) walkFunc {
+	sorted := funk.UniqString(append([]string{}, keys...))
+	sort.Strings(sorted)
+	return func(txn *badger.Txn, cursor pageCursor, fn visitFunc) error {
+		after, err := cursor.after(keyCursor)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
+			return err
 		}
-		if match {
+		for _, key := range sorted {
+			if after != nil && key <= string(after) {
+				continue
+			}
+			item, err := txn.Get([]byte(key))
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "failed to get key: %s %s", key, err.Error())
+			}
+			if item.UserMeta() != objectMeta {
+				continue
+			}
 			res, err := item.ValueCopy(nil)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+				return status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
 			}
 			var obj = &api.ObjectDetail{}
 			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+				return status.Errorf(codes.Internal, "%s failed to unmarshal protobuf: %s", key, err.Error())
+			}
+			if !matchDetail(filter, obj) || (accept != nil && !accept(obj)) {
+				continue
+			}
+			if err := fn(pageCursor{kind: keyCursor, key: []byte(key)}, obj); err != nil {
+				return err
 			}
-			objects[string(item.Key())] = obj
 		}
-
+		return nil
 	}
-	return objects, nil
 }
 
-func GetPrefix(db *badger.DB, prefix string) (map[string]*api.ObjectDetail, error) {
-	txn := db.NewTransaction(false)
+func Delete(db *badger.DB, hub *stream.Hub, keys []string) error {
+	if len(keys) > 0 && keys[0] == "*" {
+		return dropAll(db, hub)
+	}
+	txn := db.NewTransaction(true)
 	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer iter.Close()
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		res, err := item.ValueCopy(nil)
+	var deleted []*api.ObjectDetail
+	for _, key := range keys {
+		previous, err := getObjectDetail(txn, key)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			return status.Errorf(codes.Internal, "failed to get object: %s %s", key, err.Error())
+		}
+		if previous != nil {
+			if err := unindexObject(txn, previous.Object); err != nil {
+				return status.Errorf(codes.Internal, "failed to unindex key: %s %s", key, err.Error())
+			}
+			previous.Event = api.ObjectEventType_Deleted
+			deleted = append(deleted, previous)
 		}
-		var obj = &api.ObjectDetail{}
-		if err := proto.Unmarshal(res, obj); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		if err := txn.Delete([]byte(key)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
 		}
-		objects[string(item.Key())] = obj
 	}
-	return objects, nil
+	if err := commitPublish(db, txn, hub, deleted, nil); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete keys %s", err.Error())
+	}
+	for _, key := range keys {
+		refreshWatchers(db, nil, hub, key, nil)
+	}
+	return nil
+}
+
+// unindexObject removes the index entries(expiration, spatial, metadata, trackers) and dwell states of the stored version of an object
+// inside the given write transaction
+func unindexObject(txn *badger.Txn, previous *api.Object) error {
+	if err := unindexExpiry(txn, previous); err != nil {
+		return err
+	}
+	if err := unindexGeohash(txn, previous); err != nil {
+		return err
+	}
+	if err := unindexMetadata(txn, previous); err != nil {
+		return err
+	}
+	if err := unindexTrackers(txn, previous); err != nil {
+		return err
+	}
+	return unindexDwell(txn, previous.Key)
 }
 
-func Delete(db *badger.DB, keys []string) error {
+// dropAllKeeps are the reserved prefixes of the state dropAll keeps: registrations and bookkeeping rather than state derived from objects.
+// Dead letters hold object payloads, so they are dropped with the objects.
+var dropAllKeeps = []string{
+	webhookPrefix,
+	geofencePrefix,
+	geofenceIndexPrefix,
+	// index declarations and markers, the change log sequence
+	reservedPrefix + "meta_",
+	// the maps client stores its daily budget here
+	reservedPrefix + "gmaps_",
+}
+
+// dropAll deletes every object along with the state derived from objects(indexes, history, dwell state, dead letters and the change log) and
+// publishes a DropAll event. The reserved state in dropAllKeeps survives it.
+func dropAll(db *badger.DB, hub *stream.Hub) error {
+	publishMu.Lock()
+	bounds, err := changeLogOf(db)
+	if err != nil {
+		publishMu.Unlock()
+		return status.Errorf(codes.Internal, "failed to get sequence: %s", err.Error())
+	}
+	kept, err := keptEntries(db)
+	if err != nil {
+		publishMu.Unlock()
+		return status.Errorf(codes.Internal, "failed to get reserved keys: %s", err.Error())
+	}
+	if err := db.DropAll(); err != nil {
+		publishMu.Unlock()
+		return status.Errorf(codes.Internal, "failed to delete key: %s", err.Error())
+	}
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, entry := range kept {
+		if err := wb.SetEntry(entry); err != nil {
+			publishMu.Unlock()
+			return status.Errorf(codes.Internal, "failed to restore reserved key: %s", err.Error())
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		publishMu.Unlock()
+		return status.Errorf(codes.Internal, "failed to restore reserved keys: %s", err.Error())
+	}
+	invalidateWebhooks(db)
+	// the change log is gone, sequences keep increasing so resuming streams see that it was truncated
+	bounds.oldest = bounds.last + 1
+	publishMu.Unlock()
 	txn := db.NewTransaction(true)
 	defer txn.Discard()
-	if len(keys) > 0 && keys[0] == "*" {
-		if err := db.DropAll(); err != nil {
-			return status.Errorf(codes.Internal, "failed to delete key: %s", err.Error())
-		}
-	} else {
-		for _, key := range keys {
-			if err := txn.Delete([]byte(key)); err != nil {
-				return status.Errorf(codes.Internal, "failed to delete key: %s %s", key, err.Error())
+	if err := commitPublish(db, txn, hub, []*api.ObjectDetail{{Event: api.ObjectEventType_DropAll}}, nil); err != nil {
+		return status.Errorf(codes.Internal, "failed to set sequence: %s", err.Error())
+	}
+	return nil
+}
+
+// keptEntries copies the entries stored under the prefixes of dropAllKeeps
+func keptEntries(db *badger.DB) ([]*badger.Entry, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	var kept []*badger.Entry
+	for _, prefix := range dropAllKeeps {
+		for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
+			item := iter.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return nil, err
 			}
+			kept = append(kept, &badger.Entry{
+				Key:       item.KeyCopy(nil),
+				Value:     value,
+				UserMeta:  item.UserMeta(),
+				ExpiresAt: item.ExpiresAt(),
+			})
 		}
 	}
-	if err := txn.Commit(); err != nil {
-		return status.Errorf(codes.Internal, "failed to delete keys %s", err.Error())
-	}
-	return nil
+	return kept, nil
 }
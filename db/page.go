@@ -0,0 +1,111 @@
+package db
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ObjectFunc is called for every object of a listing, in order. pageToken resumes the listing after the object.
+type ObjectFunc func(detail *api.ObjectDetail, pageToken string) error
+
+// ErrStopListing may be returned by an ObjectFunc to end the listing early without an error
+var ErrStopListing = errors.New("stop listing")
+
+const (
+	// keyCursor page tokens resume a listing after an object key
+	keyCursor byte = 'k'
+	// geohashCursor page tokens resume a listing after a geohash index key
+	geohashCursor byte = 'g'
+)
+
+// pageCursor is the Badger key a listing resumes after and the kind of keys the listing walked, so a token is never applied to a listing
+// that walks another kind of keys(e.g. a spatial scan that can use a metadata index after the index was added)
+type pageCursor struct {
+	kind byte
+	key  []byte
+}
+
+// after returns the key to resume after if the listing walks keys of the kind, nil if the listing starts at the beginning
+func (c pageCursor) after(kind byte) ([]byte, error) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if c.kind != kind {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token: the token belongs to a different listing")
+	}
+	return c.key, nil
+}
+
+// visitFunc is called by the walkers of a listing in ascending cursor order
+type visitFunc func(cursor pageCursor, detail *api.ObjectDetail) error
+
+// walkFunc walks a listing inside the transaction, starting after the cursor(an empty cursor starts at the beginning)
+type walkFunc func(txn *badger.Txn, after pageCursor, fn visitFunc) error
+
+func encodePageToken(cursor pageCursor) string {
+	return base64.RawURLEncoding.EncodeToString(append([]byte{cursor.kind}, cursor.key...))
+}
+
+func decodePageToken(token string) (pageCursor, error) {
+	if token == "" {
+		return pageCursor{}, nil
+	}
+	bits, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, status.Errorf(codes.InvalidArgument, "invalid page token: %s", err.Error())
+	}
+	if len(bits) < 2 || (bits[0] != keyCursor && bits[0] != geohashCursor) {
+		return pageCursor{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return pageCursor{kind: bits[0], key: bits[1:]}, nil
+}
+
+// list walks the listing starting after the page token inside a read transaction and calls fn for every object
+func list(db *badger.DB, pageToken string, walk walkFunc, fn ObjectFunc) error {
+	after, err := decodePageToken(pageToken)
+	if err != nil {
+		return err
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	if err := walk(txn, after, func(cursor pageCursor, detail *api.ObjectDetail) error {
+		return fn(detail, encodePageToken(cursor))
+	}); err != nil && err != ErrStopListing {
+		return err
+	}
+	return nil
+}
+
+// Page collects up to size objects of the listing into a map and returns the page token of the next page, which is empty once the listing
+// is complete. A size of zero collects every object.
+func Page(size int32, listing func(fn ObjectFunc) error) (map[string]*api.ObjectDetail, string, error) {
+	objects := map[string]*api.ObjectDetail{}
+	var last, next string
+	if err := listing(func(detail *api.ObjectDetail, pageToken string) error {
+		if size > 0 && len(objects) == int(size) {
+			// there is at least one more object
+			next = last
+			return ErrStopListing
+		}
+		objects[detail.Object.Key] = detail
+		last = pageToken
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+	return objects, next, nil
+}
+
+// seekAfter positions the iterator at start, or right after the cursor if the cursor sorts at or after start
+func seekAfter(iter *badger.Iterator, start, after []byte) {
+	if after != nil && bytes.Compare(after, start) >= 0 {
+		iter.Seek(append(append([]byte{}, after...), 0))
+		return
+	}
+	iter.Seek(start)
+}
@@ -3,122 +3,191 @@ package db
 import (
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/dgraph-io/badger/v2"
-	"github.com/gogo/protobuf/proto"
 	geo "github.com/paulmach/go.geo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
 	"regexp"
+	"sort"
+	"strings"
 )
 
-func ScanBound(db *badger.DB, bound *api.Bound, keys []string) (map[string]*api.ObjectDetail, error) {
+const (
+	// nearestStartRadius is the radius in meters of the first boundary searched by Nearest
+	nearestStartRadius = 1000
+	// maxEarthDistance is half of the earths circumference in meters, no two points are further apart
+	maxEarthDistance = 20037508
+)
+
+// ScanBound calls fn for the objects stored at keys, or every object if zero keys are present, that are within the boundary and match
+// the filter, starting after the page token(optional). Boundaries crossing the antimeridian have a west longitude east of their east longitude.
+func ScanBound(db *badger.DB, bound *api.Bound, keys []string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
 	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
+	return scanKeys(db, geoBound, keys, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
+	return scanRegex(db, geoBound, rgex, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
+	return scanPrefix(db, geoBound, prefix, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+func ScanPolygon(db *badger.DB, polygon *api.Polygon, keys []string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := validatePolygon(polygon); err != nil {
+		return err
+	}
+	return scanKeys(db, polygonBound(polygon), keys, filter, func(point *geo.Point) bool {
+		return polygonContains(polygon, point)
+	}, pageToken, fn)
+}
+
+func ScanRegexPolygon(db *badger.DB, polygon *api.Polygon, rgex string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := validatePolygon(polygon); err != nil {
+		return err
+	}
+	return scanRegex(db, polygonBound(polygon), rgex, filter, func(point *geo.Point) bool {
+		return polygonContains(polygon, point)
+	}, pageToken, fn)
+}
+
+func ScanPrefixPolygon(db *badger.DB, polygon *api.Polygon, prefix string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	if err := validatePolygon(polygon); err != nil {
+		return err
+	}
+	return scanPrefix(db, polygonBound(polygon), prefix, filter, func(point *geo.Point) bool {
+		return polygonContains(polygon, point)
+	}, pageToken, fn)
+}
+
+func ScanBox(db *badger.DB, box *api.Box, keys []string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	geoBound, err := boxBound(box)
+	if err != nil {
+		return err
+	}
+	return scanKeys(db, geoBound, keys, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+func ScanRegexBox(db *badger.DB, box *api.Box, rgex string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	geoBound, err := boxBound(box)
+	if err != nil {
+		return err
+	}
+	return scanRegex(db, geoBound, rgex, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+func ScanPrefixBox(db *badger.DB, box *api.Box, prefix string, filter *api.MetadataFilter, pageToken string, fn ObjectFunc) error {
+	geoBound, err := boxBound(box)
+	if err != nil {
+		return err
+	}
+	return scanPrefix(db, geoBound, prefix, filter, func(point *geo.Point) bool {
+		return boundContains(geoBound, point)
+	}, pageToken, fn)
+}
+
+// scanKeys lists the objects stored at keys that are contained by the area and match the filter. If zero keys are present, the spatial index is walked instead.
+func scanKeys(db *badger.DB, geoBound *geo.Bound, keys []string, filter *api.MetadataFilter, contains func(point *geo.Point) bool, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
 	if len(keys) > 0 {
-		for _, key := range keys {
-			item, err := txn.Get([]byte(key))
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get key: %s", err.Error())
-			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
-				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-					objects[string(item.Key())] = obj
-				}
-			}
-		}
-	} else {
-		iter := txn.NewIterator(badger.DefaultIteratorOptions)
-		defer iter.Close()
-		for iter.Rewind(); iter.Valid(); iter.Next() {
-			item := iter.Item()
-			if item.UserMeta() != 1 {
-				continue
-			}
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			if len(res) > 0 {
-				var obj = &api.ObjectDetail{}
-				if err := proto.Unmarshal(res, obj); err != nil {
-					return nil, status.Errorf(codes.Internal, "(all) %s failed to unmarshal protobuf: %s", string(item.Key()), err.Error())
-				}
-				if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-					objects[string(item.Key())] = obj
-				}
-			}
-		}
+		return list(db, pageToken, walkKeys(keys, filter, func(obj *api.ObjectDetail) bool {
+			return obj.Object.Point != nil && contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon))
+		}), fn)
 	}
-	return objects, nil
+	return list(db, pageToken, walkScan(geoBound, filter, nil, contains), fn)
 }
 
-func ScanRegexBound(db *badger.DB, bound *api.Bound, rgex string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	opts := badger.DefaultIteratorOptions
-	opts.PrefetchValues = false
-	iter := txn.NewIterator(opts)
-	defer iter.Close()
-	for iter.Rewind(); iter.Valid(); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		match, err := regexp.MatchString(rgex, string(item.Key()))
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "failed to match regex: %s", err.Error())
-		}
-		if match {
-			res, err := item.ValueCopy(nil)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
-			}
-			var obj = &api.ObjectDetail{}
-			if err := proto.Unmarshal(res, obj); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
-			}
-			if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-				objects[string(item.Key())] = obj
+func scanRegex(db *badger.DB, geoBound *geo.Bound, rgex string, filter *api.MetadataFilter, contains func(point *geo.Point) bool, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
+	rx, err := regexp.Compile(rgex)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to compile regex: %s", err.Error())
+	}
+	return list(db, pageToken, walkScan(geoBound, filter, rx.MatchString, contains), fn)
+}
+
+func scanPrefix(db *badger.DB, geoBound *geo.Bound, prefix string, filter *api.MetadataFilter, contains func(point *geo.Point) bool, pageToken string, fn ObjectFunc) error {
+	if err := ValidateMetadataFilter(filter); err != nil {
+		return err
+	}
+	return list(db, pageToken, walkScan(geoBound, filter, func(key string) bool {
+		return strings.HasPrefix(key, prefix)
+	}, contains), fn)
+}
+
+// walkScan walks the objects with keys accepted by match(optional) that are contained by the area and match the filter
+func walkScan(geoBound *geo.Bound, filter *api.MetadataFilter, match func(key string) bool, contains func(point *geo.Point) bool) walkFunc {
+	return func(txn *badger.Txn, after pageCursor, fn visitFunc) error {
+		return planScan(txn, geoBound, filter, after, func(cursor pageCursor, key string, obj *api.ObjectDetail) error {
+			if (match == nil || match(key)) && matchDetail(filter, obj) && contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
+				return fn(cursor, obj)
 			}
-		}
+			return nil
+		})
 	}
-	return objects, nil
 }
 
-func ScanPrefixBound(db *badger.DB, bound *api.Bound, prefix string) (map[string]*api.ObjectDetail, error) {
-	geoBound := geo.NewGeoBoundAroundPoint(geo.NewPointFromLatLng(bound.Center.Lat, bound.Center.Lon), bound.Radius)
-	txn := db.NewTransaction(false)
-	defer txn.Discard()
-	objects := map[string]*api.ObjectDetail{}
-	iter := txn.NewIterator(badger.DefaultIteratorOptions)
-	defer iter.Close()
-	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
-		item := iter.Item()
-		if item.UserMeta() != 1 {
-			continue
-		}
-		res, err := item.ValueCopy(nil)
+// Nearest returns up to limit objects ordered by their haversine distance from the point. The searched radius starts small and doubles until
+// enough objects are found or maxDistance(if present) is reached. Prefix and regex optionally filter the object keys.
+func Nearest(db *badger.DB, point *api.Point, limit int, maxDistance float64, prefix, rgex string) ([]*api.NearestResult, error) {
+	var rx *regexp.Regexp
+	if rgex != "" {
+		r, err := regexp.Compile(rgex)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+			return nil, status.Errorf(codes.InvalidArgument, "failed to compile regex: %s", err.Error())
 		}
-		var obj = &api.ObjectDetail{}
-		if err := proto.Unmarshal(res, obj); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		rx = r
+	}
+	if maxDistance <= 0 || maxDistance > maxEarthDistance {
+		maxDistance = maxEarthDistance
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	center := geo.NewPointFromLatLng(point.Lat, point.Lon)
+	var results []*api.NearestResult
+	for radius := math.Min(nearestStartRadius, maxDistance); ; radius = math.Min(radius*2, maxDistance) {
+		results = nil
+		if err := scanGeohash(txn, geo.NewGeoBoundAroundPoint(center, radius), nil, func(cursor []byte, key string, obj *api.ObjectDetail) error {
+			if !strings.HasPrefix(key, prefix) || (rx != nil && !rx.MatchString(key)) {
+				return nil
+			}
+			if dist := distance(point, obj.Object.Point); dist <= radius {
+				results = append(results, &api.NearestResult{
+					Object:   obj,
+					Distance: dist,
+				})
+			}
+			return nil
+		}); err != nil {
+			return nil, err
 		}
-		if geoBound.Contains(geo.NewPointFromLatLng(obj.Object.Point.Lat, obj.Object.Point.Lon)) {
-			objects[string(item.Key())] = obj
+		// every object closer than radius has been found, so the closest results are final once there are enough of them
+		if len(results) >= limit || radius >= maxDistance {
+			break
 		}
 	}
-	return objects, nil
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Distance < results[j].Distance
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
 }
@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/maps"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+const (
+	// trackerIndexPrefix namespaces the reverse tracker index: trackerIndexPrefix + base64(target key) + "_" + watcher key
+	trackerIndexPrefix = reservedPrefix + "tracker_"
+	trackerIndexMarker = reservedPrefix + "meta_tracker_index"
+)
+
+func trackerIndexTargetPrefix(target string) string {
+	return trackerIndexPrefix + indexEncoding.EncodeToString([]byte(target)) + "_"
+}
+
+func trackerIndexKey(target, watcher string) []byte {
+	return []byte(trackerIndexTargetPrefix(target) + watcher)
+}
+
+// indexTrackers updates the reverse tracker index entries of obj inside the given write transaction, removing the entries of the
+// trackers of its previous version
+func indexTrackers(txn *badger.Txn, previous *api.Object, obj *api.Object) error {
+	for _, tracker := range previous.GetTracking().GetTrackers() {
+		if err := txn.Delete(trackerIndexKey(tracker.TargetObjectKey, obj.Key)); err != nil {
+			return err
+		}
+	}
+	for _, tracker := range obj.GetTracking().GetTrackers() {
+		if err := txn.SetEntry(&badger.Entry{
+			Key:       trackerIndexKey(tracker.TargetObjectKey, obj.Key),
+			UserMeta:  trackerIndexMeta,
+			ExpiresAt: uint64(obj.ExpiresUnix),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexTrackers removes the reverse tracker index entries of the stored version of an object inside the given write transaction
+func unindexTrackers(txn *badger.Txn, previous *api.Object) error {
+	for _, tracker := range previous.GetTracking().GetTrackers() {
+		if err := txn.Delete(trackerIndexKey(tracker.TargetObjectKey, previous.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trackerWatchers returns the keys of the objects tracking the target
+func trackerWatchers(txn *badger.Txn, target string) []string {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	var watchers []string
+	prefix := trackerIndexTargetPrefix(target)
+	for iter.Seek([]byte(prefix)); iter.ValidForPrefix([]byte(prefix)); iter.Next() {
+		if iter.Item().UserMeta() != trackerIndexMeta {
+			continue
+		}
+		watchers = append(watchers, strings.TrimPrefix(string(iter.Item().Key()), prefix))
+	}
+	return watchers
+}
+
+// RebuildTrackerIndex writes the reverse tracker index entries of every stored object. It runs once against databases created before the index existed.
+func RebuildTrackerIndex(db *badger.DB) error {
+	txn := db.NewTransaction(false)
+	_, err := txn.Get([]byte(trackerIndexMarker))
+	txn.Discard()
+	if err == nil {
+		return nil
+	}
+	if err != badger.ErrKeyNotFound {
+		return err
+	}
+	txn = db.NewTransaction(false)
+	defer txn.Discard()
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	for iter.Rewind(); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != objectMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		var obj = &api.ObjectDetail{}
+		if err := proto.Unmarshal(res, obj); err != nil {
+			return err
+		}
+		for _, tracker := range obj.GetObject().GetTracking().GetTrackers() {
+			if err := wb.SetEntry(&badger.Entry{
+				Key:       trackerIndexKey(tracker.TargetObjectKey, string(item.Key())),
+				UserMeta:  trackerIndexMeta,
+				ExpiresAt: item.ExpiresAt(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := wb.SetEntry(&badger.Entry{
+		Key:      []byte(trackerIndexMarker),
+		UserMeta: trackerIndexMeta,
+	}); err != nil {
+		return err
+	}
+	return wb.Flush()
+}
+
+// evaluateTracker returns the tracker event of val in relation to the target object as of timestamp
+func evaluateTracker(maps *maps.Client, val *api.Object, tracker *api.ObjectTracker, target *api.Object, timestamp int64) *api.TrackerEvent {
+	dist := distance(val.Point, target.Point)
+	trackerEvent := &api.TrackerEvent{
+		Object:        target,
+		Distance:      dist,
+		Inside:        dist <= float64(val.Radius+target.Radius),
+		TimestampUnix: timestamp,
+	}
+	if maps != nil && val.Tracking != nil {
+		directions, eta, dist, err := maps.TravelDetail(context.Background(), val.Point, target.Point, val.GetTracking().GetTravelMode())
+		if err != nil {
+			log.Error(err.Error())
+		} else {
+			trackerEvent.Direction = &api.Directions{}
+			if tracker.TrackDirections {
+				trackerEvent.Direction.HtmlDirections = directions
+			}
+			if tracker.TrackEta {
+				trackerEvent.Direction.Eta = int64(eta)
+			}
+			if tracker.TrackDistance {
+				trackerEvent.Direction.TravelDist = int64(dist)
+			}
+		}
+	}
+	return trackerEvent
+}
+
+// refreshWatchers recomputes and publishes the tracker events of every object tracking key. target is the new version of the object stored
+// at key or nil if it was deleted.
+func refreshWatchers(db *badger.DB, maps *maps.Client, hub *stream.Hub, key string, target *api.Object) {
+	txn := db.NewTransaction(false)
+	watchers := trackerWatchers(txn, key)
+	txn.Discard()
+	for _, watcher := range watchers {
+		if watcher == key {
+			continue
+		}
+		if err := refreshWatcher(db, maps, hub, watcher, key, target); err != nil {
+			log.Errorf("%s failed to refresh tracker of %s: %s", watcher, key, err.Error())
+		}
+	}
+}
+
+func refreshWatcher(db *badger.DB, maps *maps.Client, hub *stream.Hub, watcher, key string, target *api.Object) error {
+	txn := db.NewTransaction(false)
+	detail, err := getObjectDetail(txn, watcher)
+	txn.Discard()
+	if err != nil || detail == nil || detail.Object == nil || detail.Object.Point == nil {
+		return err
+	}
+	nowUnix := time.Now().Unix()
+	var (
+		event     *api.TrackerEvent
+		threshold int64
+	)
+	if target != nil {
+		nowUnix = target.UpdatedUnix
+		for _, tracker := range detail.Object.GetTracking().GetTrackers() {
+			if tracker.TargetObjectKey == key {
+				// travel details may take a while, so they are computed outside of the write transaction
+				event = evaluateTracker(maps, detail.Object, tracker, target, target.UpdatedUnix)
+				threshold = dwellThreshold(tracker.DwellSeconds)
+			}
+		}
+	}
+	wtxn := db.NewTransaction(true)
+	defer wtxn.Discard()
+	// the watcher is read again so a concurrent update of the watcher isn't overwritten
+	current, err := getObjectDetail(wtxn, watcher)
+	if err != nil || current == nil || current.Object == nil {
+		return err
+	}
+	var trackerEvents []*api.TrackerEvent
+	for _, e := range current.TrackerEvents {
+		if e.GetObject().GetKey() != key {
+			trackerEvents = append(trackerEvents, e)
+		}
+	}
+	seconds, dwelling, _, err := updateDwell(wtxn, dwellTracker, watcher, key, event != nil && event.Inside, nowUnix, threshold)
+	if err != nil {
+		return err
+	}
+	if event != nil {
+		event.DwellSeconds = seconds
+		event.Dwelling = dwelling && event.Inside
+		trackerEvents = append(trackerEvents, event)
+	}
+	current.TrackerEvents = trackerEvents
+	current.Event = api.ObjectEventType_Set
+	return commitPublish(db, wtxn, hub, []*api.ObjectDetail{current}, func() error {
+		return storeObject(wtxn, current.Object, current)
+	})
+}
@@ -0,0 +1,230 @@
+package db
+
+import (
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"sync"
+)
+
+const (
+	// webhookPrefix namespaces webhook subscriptions: webhookPrefix + webhook id
+	webhookPrefix = reservedPrefix + "webhook_"
+	// deadLetterPrefix namespaces the webhook dead letter queue: deadLetterPrefix + delivery id
+	deadLetterPrefix = reservedPrefix + "deadletter_"
+)
+
+var (
+	webhooksMu = &sync.Mutex{}
+	// webhookCaches caches every webhook of a database so dispatching stream events doesn't scan them each time
+	webhookCaches = map[*badger.DB]*webhookCache{}
+)
+
+type webhookCache struct {
+	// generation is bumped whenever the webhooks change so a scan that raced the change isn't cached
+	generation uint64
+	hooks      map[string]*api.Webhook
+}
+
+// webhookCacheOf returns the webhook cache of the database. The caller must hold webhooksMu.
+func webhookCacheOf(db *badger.DB) *webhookCache {
+	cache, ok := webhookCaches[db]
+	if !ok {
+		cache = &webhookCache{}
+		webhookCaches[db] = cache
+	}
+	return cache
+}
+
+// invalidateWebhooks drops the cached webhooks of the database after they were set, deleted or dropped
+func invalidateWebhooks(db *badger.DB) {
+	webhooksMu.Lock()
+	defer webhooksMu.Unlock()
+	cache := webhookCacheOf(db)
+	cache.generation++
+	cache.hooks = nil
+}
+
+func SetWebhook(db *badger.DB, hook *api.Webhook) (*api.Webhook, error) {
+	if err := hook.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if hook.Regex != "" {
+		if _, err := regexp.Compile(hook.Regex); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to compile regex: %s", err.Error())
+		}
+	}
+	bits, err := proto.Marshal(hook)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal webhook: %s", err.Error())
+	}
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	if err := txn.SetEntry(&badger.Entry{
+		Key:      []byte(webhookPrefix + hook.Id),
+		Value:    bits,
+		UserMeta: webhookMeta,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set webhook: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set webhook: %s", err.Error())
+	}
+	invalidateWebhooks(db)
+	return hook, nil
+}
+
+// GetWebhooks returns the webhooks stored at ids, or every webhook if zero ids are present. Every webhook is served from a cache that
+// is invalidated whenever webhooks are set or deleted, so the returned webhooks are shared and must not be modified.
+func GetWebhooks(db *badger.DB, ids []string) (map[string]*api.Webhook, error) {
+	if len(ids) == 0 {
+		webhooksMu.Lock()
+		cache := webhookCacheOf(db)
+		generation, cached := cache.generation, cache.hooks
+		webhooksMu.Unlock()
+		if cached == nil {
+			var err error
+			cached, err = scanWebhooks(db)
+			if err != nil {
+				return nil, err
+			}
+			webhooksMu.Lock()
+			if cache.generation == generation {
+				cache.hooks = cached
+			}
+			webhooksMu.Unlock()
+		}
+		hooks := make(map[string]*api.Webhook, len(cached))
+		for id, hook := range cached {
+			hooks[id] = hook
+		}
+		return hooks, nil
+	}
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	hooks := map[string]*api.Webhook{}
+	for _, id := range ids {
+		item, err := txn.Get([]byte(webhookPrefix + id))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to get webhook: %s %s", id, err.Error())
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var hook = &api.Webhook{}
+		if err := proto.Unmarshal(res, hook); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		hooks[id] = hook
+	}
+	return hooks, nil
+}
+
+// scanWebhooks reads every stored webhook
+func scanWebhooks(db *badger.DB) (map[string]*api.Webhook, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	hooks := map[string]*api.Webhook{}
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	prefix := []byte(webhookPrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != webhookMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var hook = &api.Webhook{}
+		if err := proto.Unmarshal(res, hook); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		hooks[hook.Id] = hook
+	}
+	return hooks, nil
+}
+
+func DeleteWebhooks(db *badger.DB, ids []string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	for _, id := range ids {
+		if err := txn.Delete([]byte(webhookPrefix + id)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete webhook: %s %s", id, err.Error())
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete webhooks %s", err.Error())
+	}
+	invalidateWebhooks(db)
+	return nil
+}
+
+// AddDeadLetter parks a delivery that failed after every retry in the dead letter queue
+func AddDeadLetter(db *badger.DB, delivery *api.WebhookDelivery) error {
+	bits, err := proto.Marshal(delivery)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal dead letter: %s", err.Error())
+	}
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	if err := txn.SetEntry(&badger.Entry{
+		Key:      []byte(deadLetterPrefix + delivery.Id),
+		Value:    bits,
+		UserMeta: deadLetterMeta,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to set dead letter: %s", err.Error())
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to set dead letter: %s", err.Error())
+	}
+	return nil
+}
+
+// GetDeadLetters returns the dead letters of the webhook, or every dead letter if webhookID is empty
+func GetDeadLetters(db *badger.DB, webhookID string) ([]*api.WebhookDelivery, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	iter := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer iter.Close()
+	var deliveries []*api.WebhookDelivery
+	prefix := []byte(deadLetterPrefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != deadLetterMeta {
+			continue
+		}
+		res, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy data: %s", err.Error())
+		}
+		var delivery = &api.WebhookDelivery{}
+		if err := proto.Unmarshal(res, delivery); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal protobuf: %s", err.Error())
+		}
+		if webhookID != "" && delivery.WebhookId != webhookID {
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+func DeleteDeadLetters(db *badger.DB, ids []string) error {
+	txn := db.NewTransaction(true)
+	defer txn.Discard()
+	for _, id := range ids {
+		if err := txn.Delete([]byte(deadLetterPrefix + id)); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete dead letter: %s %s", id, err.Error())
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete dead letters %s", err.Error())
+	}
+	return nil
+}
@@ -25,6 +25,149 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+//ObjectEventType is the kind of change an object detail is published for
+type ObjectEventType int32
+
+const (
+	ObjectEventType_Set      ObjectEventType = 0
+	ObjectEventType_Deleted  ObjectEventType = 1
+	ObjectEventType_Expired  ObjectEventType = 2
+	ObjectEventType_DropAll  ObjectEventType = 3
+	ObjectEventType_Enriched ObjectEventType = 4
+)
+
+var ObjectEventType_name = map[int32]string{
+	0: "Set",
+	1: "Deleted",
+	2: "Expired",
+	3: "DropAll",
+	4: "Enriched",
+}
+
+var ObjectEventType_value = map[string]int32{
+	"Set":      0,
+	"Deleted":  1,
+	"Expired":  2,
+	"DropAll":  3,
+	"Enriched": 4,
+}
+
+func (x ObjectEventType) String() string {
+	return proto.EnumName(ObjectEventType_name, int32(x))
+}
+
+func (ObjectEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+//GeofenceTransition describes how an object update relates to a geofence compared to the objects previous position
+type GeofenceTransition int32
+
+const (
+	GeofenceTransition_Inside GeofenceTransition = 0
+	GeofenceTransition_Enter  GeofenceTransition = 1
+	GeofenceTransition_Exit   GeofenceTransition = 2
+	GeofenceTransition_Dwell  GeofenceTransition = 3
+)
+
+var GeofenceTransition_name = map[int32]string{
+	0: "Inside",
+	1: "Enter",
+	2: "Exit",
+	3: "Dwell",
+}
+
+var GeofenceTransition_value = map[string]int32{
+	"Inside": 0,
+	"Enter":  1,
+	"Exit":   2,
+	"Dwell":  3,
+}
+
+func (x GeofenceTransition) String() string {
+	return proto.EnumName(GeofenceTransition_name, int32(x))
+}
+
+func (GeofenceTransition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+//WebhookEventType is the kind of event delivered to a webhook
+type WebhookEventType int32
+
+const (
+	WebhookEventType_ObjectUpdated     WebhookEventType = 0
+	WebhookEventType_TrackerUpdated    WebhookEventType = 1
+	WebhookEventType_GeofenceTriggered WebhookEventType = 2
+	WebhookEventType_ObjectDeleted     WebhookEventType = 3
+	WebhookEventType_ObjectExpired     WebhookEventType = 4
+	WebhookEventType_ObjectEnriched    WebhookEventType = 5
+)
+
+var WebhookEventType_name = map[int32]string{
+	0: "ObjectUpdated",
+	1: "TrackerUpdated",
+	2: "GeofenceTriggered",
+	3: "ObjectDeleted",
+	4: "ObjectExpired",
+	5: "ObjectEnriched",
+}
+
+var WebhookEventType_value = map[string]int32{
+	"ObjectUpdated":     0,
+	"TrackerUpdated":    1,
+	"GeofenceTriggered": 2,
+	"ObjectDeleted":     3,
+	"ObjectExpired":     4,
+	"ObjectEnriched":    5,
+}
+
+func (x WebhookEventType) String() string {
+	return proto.EnumName(WebhookEventType_name, int32(x))
+}
+
+func (WebhookEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+//MetadataOperator is the comparison a MetadataCondition applies to a metadata value
+type MetadataOperator int32
+
+const (
+	MetadataOperator_Equal     MetadataOperator = 0
+	MetadataOperator_NotEqual  MetadataOperator = 1
+	MetadataOperator_In        MetadataOperator = 2
+	MetadataOperator_NotIn     MetadataOperator = 3
+	MetadataOperator_Exists    MetadataOperator = 4
+	MetadataOperator_NotExists MetadataOperator = 5
+)
+
+var MetadataOperator_name = map[int32]string{
+	0: "Equal",
+	1: "NotEqual",
+	2: "In",
+	3: "NotIn",
+	4: "Exists",
+	5: "NotExists",
+}
+
+var MetadataOperator_value = map[string]int32{
+	"Equal":     0,
+	"NotEqual":  1,
+	"In":        2,
+	"NotIn":     3,
+	"Exists":    4,
+	"NotExists": 5,
+}
+
+func (x MetadataOperator) String() string {
+	return proto.EnumName(MetadataOperator_name, int32(x))
+}
+
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
 //TravelMode is used to generate directions based on the type of travel the object is utilizing. only necessary if using google maps
 type TravelMode int32
 
@@ -54,7 +197,39 @@ func (x TravelMode) String() string {
 }
 
 func (TravelMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+//MapsCacheType is a kind of cached maps response
+type MapsCacheType int32
+
+const (
+	MapsCacheType_DirectionsCache  MapsCacheType = 0
+	MapsCacheType_AddressCache     MapsCacheType = 1
+	MapsCacheType_TimezoneCache    MapsCacheType = 2
+	MapsCacheType_CoordinatesCache MapsCacheType = 3
+)
+
+var MapsCacheType_name = map[int32]string{
+	0: "DirectionsCache",
+	1: "AddressCache",
+	2: "TimezoneCache",
+	3: "CoordinatesCache",
+}
+
+var MapsCacheType_value = map[string]int32{
+	"DirectionsCache":  0,
+	"AddressCache":     1,
+	"TimezoneCache":    2,
+	"CoordinatesCache": 3,
+}
+
+func (x MapsCacheType) String() string {
+	return proto.EnumName(MapsCacheType_name, int32(x))
+}
+
+func (MapsCacheType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 //A Point is a simple X/Y or Lng/Lat 2d point. [X, Y] or [Lng, Lat]
@@ -152,6 +327,134 @@ func (m *Bound) GetRadius() float64 {
 	return 0
 }
 
+//A Ring is a closed line of points. The last point may repeat the first point(GeoJSON) but it is not required
+type Ring struct {
+	Points               []*Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ring) Reset()         { *m = Ring{} }
+func (m *Ring) String() string { return proto.CompactTextString(m) }
+func (*Ring) ProtoMessage()    {}
+func (*Ring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *Ring) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ring.Unmarshal(m, b)
+}
+func (m *Ring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ring.Marshal(b, m, deterministic)
+}
+func (m *Ring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ring.Merge(m, src)
+}
+func (m *Ring) XXX_Size() int {
+	return xxx_messageInfo_Ring.Size(m)
+}
+func (m *Ring) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ring proto.InternalMessageInfo
+
+func (m *Ring) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+//A Polygon is a GeoJSON style polygon. The first ring is the exterior boundary, any following rings are holes
+type Polygon struct {
+	Rings                []*Ring  `protobuf:"bytes,1,rep,name=rings,proto3" json:"rings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Polygon) Reset()         { *m = Polygon{} }
+func (m *Polygon) String() string { return proto.CompactTextString(m) }
+func (*Polygon) ProtoMessage()    {}
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *Polygon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Polygon.Unmarshal(m, b)
+}
+func (m *Polygon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Polygon.Marshal(b, m, deterministic)
+}
+func (m *Polygon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Polygon.Merge(m, src)
+}
+func (m *Polygon) XXX_Size() int {
+	return xxx_messageInfo_Polygon.Size(m)
+}
+func (m *Polygon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Polygon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Polygon proto.InternalMessageInfo
+
+func (m *Polygon) GetRings() []*Ring {
+	if m != nil {
+		return m.Rings
+	}
+	return nil
+}
+
+//A Box is a rectangular boundary made of its south-west and north-east corners. A south-west corner east of the north-east corner crosses the antimeridian
+type Box struct {
+	SouthWest            *Point   `protobuf:"bytes,1,opt,name=south_west,json=southWest,proto3" json:"south_west,omitempty"`
+	NorthEast            *Point   `protobuf:"bytes,2,opt,name=north_east,json=northEast,proto3" json:"north_east,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Box) Reset()         { *m = Box{} }
+func (m *Box) String() string { return proto.CompactTextString(m) }
+func (*Box) ProtoMessage()    {}
+func (*Box) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *Box) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Box.Unmarshal(m, b)
+}
+func (m *Box) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Box.Marshal(b, m, deterministic)
+}
+func (m *Box) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Box.Merge(m, src)
+}
+func (m *Box) XXX_Size() int {
+	return xxx_messageInfo_Box.Size(m)
+}
+func (m *Box) XXX_DiscardUnknown() {
+	xxx_messageInfo_Box.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Box proto.InternalMessageInfo
+
+func (m *Box) GetSouthWest() *Point {
+	if m != nil {
+		return m.SouthWest
+	}
+	return nil
+}
+
+func (m *Box) GetNorthEast() *Point {
+	if m != nil {
+		return m.NorthEast
+	}
+	return nil
+}
+
 //An Object represents anything that has a unique identifier, and a geolocation.
 type Object struct {
 	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -172,7 +475,7 @@ func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *Object) XXX_Unmarshal(b []byte) error {
@@ -269,7 +572,7 @@ func (m *ObjectTracking) Reset()         { *m = ObjectTracking{} }
 func (m *ObjectTracking) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracking) ProtoMessage()    {}
 func (*ObjectTracking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *ObjectTracking) XXX_Unmarshal(b []byte) error {
@@ -310,6 +613,7 @@ type ObjectTracker struct {
 	TrackDirections      bool     `protobuf:"varint,2,opt,name=track_directions,json=trackDirections,proto3" json:"track_directions,omitempty"`
 	TrackDistance        bool     `protobuf:"varint,3,opt,name=track_distance,json=trackDistance,proto3" json:"track_distance,omitempty"`
 	TrackEta             bool     `protobuf:"varint,4,opt,name=track_eta,json=trackEta,proto3" json:"track_eta,omitempty"`
+	DwellSeconds         int64    `protobuf:"varint,5,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -319,7 +623,7 @@ func (m *ObjectTracker) Reset()         { *m = ObjectTracker{} }
 func (m *ObjectTracker) String() string { return proto.CompactTextString(m) }
 func (*ObjectTracker) ProtoMessage()    {}
 func (*ObjectTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *ObjectTracker) XXX_Unmarshal(b []byte) error {
@@ -368,6 +672,13 @@ func (m *ObjectTracker) GetTrackEta() bool {
 	return false
 }
 
+func (m *ObjectTracker) GetDwellSeconds() int64 {
+	if m != nil {
+		return m.DwellSeconds
+	}
+	return 0
+}
+
 //Directions if using the google maps integration
 type Directions struct {
 	HtmlDirections       string   `protobuf:"bytes,1,opt,name=html_directions,json=htmlDirections,proto3" json:"html_directions,omitempty"`
@@ -382,7 +693,7 @@ func (m *Directions) Reset()         { *m = Directions{} }
 func (m *Directions) String() string { return proto.CompactTextString(m) }
 func (*Directions) ProtoMessage()    {}
 func (*Directions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *Directions) XXX_Unmarshal(b []byte) error {
@@ -441,7 +752,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -512,6 +823,8 @@ type TrackerEvent struct {
 	Inside               bool        `protobuf:"varint,3,opt,name=inside,proto3" json:"inside,omitempty"`
 	Direction            *Directions `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
 	TimestampUnix        int64       `protobuf:"varint,5,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	DwellSeconds         int64       `protobuf:"varint,6,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
+	Dwelling             bool        `protobuf:"varint,7,opt,name=dwelling,proto3" json:"dwelling,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -521,7 +834,7 @@ func (m *TrackerEvent) Reset()         { *m = TrackerEvent{} }
 func (m *TrackerEvent) String() string { return proto.CompactTextString(m) }
 func (*TrackerEvent) ProtoMessage()    {}
 func (*TrackerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
 func (m *TrackerEvent) XXX_Unmarshal(b []byte) error {
@@ -577,12 +890,31 @@ func (m *TrackerEvent) GetTimestampUnix() int64 {
 	return 0
 }
 
+func (m *TrackerEvent) GetDwellSeconds() int64 {
+	if m != nil {
+		return m.DwellSeconds
+	}
+	return 0
+}
+
+func (m *TrackerEvent) GetDwelling() bool {
+	if m != nil {
+		return m.Dwelling
+	}
+	return false
+}
+
 //ObjectDetail is an enhanced view of an Object containing a human readable address and the objects latest tracking information
 type ObjectDetail struct {
 	Object               *Object         `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	Address              *Address        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Timezone             string          `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	TrackerEvents        []*TrackerEvent `protobuf:"bytes,4,rep,name=tracker_events,json=trackerEvents,proto3" json:"tracker_events,omitempty"`
+	Sequence             uint64          `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Event                ObjectEventType `protobuf:"varint,6,opt,name=event,proto3,enum=api.ObjectEventType" json:"event,omitempty"`
+	Version              uint64          `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	UtcOffset            int32           `protobuf:"varint,8,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	Dst                  bool            `protobuf:"varint,9,opt,name=dst,proto3" json:"dst,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -592,7 +924,7 @@ func (m *ObjectDetail) Reset()         { *m = ObjectDetail{} }
 func (m *ObjectDetail) String() string { return proto.CompactTextString(m) }
 func (*ObjectDetail) ProtoMessage()    {}
 func (*ObjectDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
 func (m *ObjectDetail) XXX_Unmarshal(b []byte) error {
@@ -641,2095 +973,7610 @@ func (m *ObjectDetail) GetTrackerEvents() []*TrackerEvent {
 	return nil
 }
 
-type StreamRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ObjectDetail) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
-func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamRequest) ProtoMessage()    {}
-func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+func (m *ObjectDetail) GetEvent() ObjectEventType {
+	if m != nil {
+		return m.Event
+	}
+	return ObjectEventType_Set
 }
 
-func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
+func (m *ObjectDetail) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
-func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
+
+func (m *ObjectDetail) GetUtcOffset() int32 {
+	if m != nil {
+		return m.UtcOffset
+	}
+	return 0
 }
-func (m *StreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRequest.Merge(m, src)
+
+func (m *ObjectDetail) GetDst() bool {
+	if m != nil {
+		return m.Dst
+	}
+	return false
 }
-func (m *StreamRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamRequest.Size(m)
+
+//A Geofence is a named, persistent area(a circle or a polygon) that every object update is evaluated against
+type Geofence struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Circle               *Bound            `protobuf:"bytes,2,opt,name=circle,proto3" json:"circle,omitempty"`
+	Polygon              *Polygon          `protobuf:"bytes,3,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DwellSeconds         int64             `protobuf:"varint,5,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *StreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
+
+func (m *Geofence) Reset()         { *m = Geofence{} }
+func (m *Geofence) String() string { return proto.CompactTextString(m) }
+func (*Geofence) ProtoMessage()    {}
+func (*Geofence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
-var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
+func (m *Geofence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Geofence.Unmarshal(m, b)
+}
+func (m *Geofence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Geofence.Marshal(b, m, deterministic)
+}
+func (m *Geofence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Geofence.Merge(m, src)
+}
+func (m *Geofence) XXX_Size() int {
+	return xxx_messageInfo_Geofence.Size(m)
+}
+func (m *Geofence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Geofence.DiscardUnknown(m)
+}
 
-func (m *StreamRequest) GetClientId() string {
+var xxx_messageInfo_Geofence proto.InternalMessageInfo
+
+func (m *Geofence) GetKey() string {
 	if m != nil {
-		return m.ClientId
+		return m.Key
 	}
 	return ""
 }
 
-func (m *StreamRequest) GetKeys() []string {
+func (m *Geofence) GetCircle() *Bound {
 	if m != nil {
-		return m.Keys
+		return m.Circle
 	}
 	return nil
 }
 
-type StreamResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *Geofence) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
 }
 
-func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
-func (m *StreamResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamResponse) ProtoMessage()    {}
-func (*StreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+func (m *Geofence) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *StreamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamResponse.Unmarshal(m, b)
+func (m *Geofence) GetDwellSeconds() int64 {
+	if m != nil {
+		return m.DwellSeconds
+	}
+	return 0
 }
-func (m *StreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamResponse.Marshal(b, m, deterministic)
+
+//GeofenceEvent is emitted when an object update is inside a geofence or crosses its boundary
+type GeofenceEvent struct {
+	Geofence             *Geofence          `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	Object               *Object            `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Transition           GeofenceTransition `protobuf:"varint,3,opt,name=transition,proto3,enum=api.GeofenceTransition" json:"transition,omitempty"`
+	TimestampUnix        int64              `protobuf:"varint,4,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	DwellSeconds         int64              `protobuf:"varint,5,opt,name=dwell_seconds,json=dwellSeconds,proto3" json:"dwell_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *StreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamResponse.Merge(m, src)
+
+func (m *GeofenceEvent) Reset()         { *m = GeofenceEvent{} }
+func (m *GeofenceEvent) String() string { return proto.CompactTextString(m) }
+func (*GeofenceEvent) ProtoMessage()    {}
+func (*GeofenceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
-func (m *StreamResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamResponse.Size(m)
+
+func (m *GeofenceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeofenceEvent.Unmarshal(m, b)
 }
-func (m *StreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamResponse.DiscardUnknown(m)
+func (m *GeofenceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeofenceEvent.Marshal(b, m, deterministic)
+}
+func (m *GeofenceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeofenceEvent.Merge(m, src)
+}
+func (m *GeofenceEvent) XXX_Size() int {
+	return xxx_messageInfo_GeofenceEvent.Size(m)
+}
+func (m *GeofenceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeofenceEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_GeofenceEvent proto.InternalMessageInfo
 
-func (m *StreamResponse) GetObject() *ObjectDetail {
+func (m *GeofenceEvent) GetGeofence() *Geofence {
 	if m != nil {
-		return m.Object
+		return m.Geofence
 	}
 	return nil
 }
 
-type StreamRegexRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+func (m *GeofenceEvent) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *GeofenceEvent) GetTransition() GeofenceTransition {
+	if m != nil {
+		return m.Transition
+	}
+	return GeofenceTransition_Inside
+}
+
+func (m *GeofenceEvent) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
+}
+
+func (m *GeofenceEvent) GetDwellSeconds() int64 {
+	if m != nil {
+		return m.DwellSeconds
+	}
+	return 0
+}
+
+//DwellState records when an object entered a geofence or started overlapping a tracked object(stored internally)
+type DwellState struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Target               string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	EnteredUnix          int64    `protobuf:"varint,4,opt,name=entered_unix,json=enteredUnix,proto3" json:"entered_unix,omitempty"`
+	Threshold            int64    `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Fired                bool     `protobuf:"varint,6,opt,name=fired,proto3" json:"fired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StreamRegexRequest) Reset()         { *m = StreamRegexRequest{} }
-func (m *StreamRegexRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamRegexRequest) ProtoMessage()    {}
-func (*StreamRegexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+func (m *DwellState) Reset()         { *m = DwellState{} }
+func (m *DwellState) String() string { return proto.CompactTextString(m) }
+func (*DwellState) ProtoMessage()    {}
+func (*DwellState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *StreamRegexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRegexRequest.Unmarshal(m, b)
+func (m *DwellState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DwellState.Unmarshal(m, b)
 }
-func (m *StreamRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRegexRequest.Marshal(b, m, deterministic)
+func (m *DwellState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DwellState.Marshal(b, m, deterministic)
 }
-func (m *StreamRegexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRegexRequest.Merge(m, src)
+func (m *DwellState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DwellState.Merge(m, src)
 }
-func (m *StreamRegexRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamRegexRequest.Size(m)
+func (m *DwellState) XXX_Size() int {
+	return xxx_messageInfo_DwellState.Size(m)
 }
-func (m *StreamRegexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRegexRequest.DiscardUnknown(m)
+func (m *DwellState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DwellState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamRegexRequest proto.InternalMessageInfo
+var xxx_messageInfo_DwellState proto.InternalMessageInfo
 
-func (m *StreamRegexRequest) GetClientId() string {
+func (m *DwellState) GetKind() string {
 	if m != nil {
-		return m.ClientId
+		return m.Kind
 	}
 	return ""
 }
 
-func (m *StreamRegexRequest) GetRegex() string {
+func (m *DwellState) GetKey() string {
 	if m != nil {
-		return m.Regex
+		return m.Key
 	}
 	return ""
 }
 
-type StreamRegexResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *StreamRegexResponse) Reset()         { *m = StreamRegexResponse{} }
-func (m *StreamRegexResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamRegexResponse) ProtoMessage()    {}
-func (*StreamRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+func (m *DwellState) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *StreamRegexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamRegexResponse.Unmarshal(m, b)
-}
-func (m *StreamRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamRegexResponse.Marshal(b, m, deterministic)
-}
-func (m *StreamRegexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamRegexResponse.Merge(m, src)
-}
-func (m *StreamRegexResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamRegexResponse.Size(m)
-}
-func (m *StreamRegexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamRegexResponse.DiscardUnknown(m)
+func (m *DwellState) GetEnteredUnix() int64 {
+	if m != nil {
+		return m.EnteredUnix
+	}
+	return 0
 }
 
-var xxx_messageInfo_StreamRegexResponse proto.InternalMessageInfo
-
-func (m *StreamRegexResponse) GetObject() *ObjectDetail {
+func (m *DwellState) GetThreshold() int64 {
 	if m != nil {
-		return m.Object
+		return m.Threshold
 	}
-	return nil
+	return 0
 }
 
-type StreamPrefixRequest struct {
-	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DwellState) GetFired() bool {
+	if m != nil {
+		return m.Fired
+	}
+	return false
 }
 
-func (m *StreamPrefixRequest) Reset()         { *m = StreamPrefixRequest{} }
-func (m *StreamPrefixRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamPrefixRequest) ProtoMessage()    {}
-func (*StreamPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+//A Webhook is a subscription that POSTs matching events to a url. Keys, prefix, regex and event types optionally narrow the events.
+type Webhook struct {
+	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string             `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Keys                 []string           `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Prefix               string             `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string             `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	EventTypes           []WebhookEventType `protobuf:"varint,6,rep,packed,name=event_types,json=eventTypes,proto3,enum=api.WebhookEventType" json:"event_types,omitempty"`
+	Secret               string             `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *StreamPrefixRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamPrefixRequest.Unmarshal(m, b)
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
 }
-func (m *StreamPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamPrefixRequest.Marshal(b, m, deterministic)
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
 }
-func (m *StreamPrefixRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPrefixRequest.Merge(m, src)
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
 }
-func (m *StreamPrefixRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamPrefixRequest.Size(m)
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
 }
-func (m *StreamPrefixRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPrefixRequest.DiscardUnknown(m)
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamPrefixRequest proto.InternalMessageInfo
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
 
-func (m *StreamPrefixRequest) GetClientId() string {
+func (m *Webhook) GetId() string {
 	if m != nil {
-		return m.ClientId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *StreamPrefixRequest) GetPrefix() string {
+func (m *Webhook) GetUrl() string {
 	if m != nil {
-		return m.Prefix
+		return m.Url
 	}
 	return ""
 }
 
-type StreamPrefixResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *Webhook) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
 
-func (m *StreamPrefixResponse) Reset()         { *m = StreamPrefixResponse{} }
-func (m *StreamPrefixResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamPrefixResponse) ProtoMessage()    {}
-func (*StreamPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+func (m *Webhook) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
-func (m *StreamPrefixResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamPrefixResponse.Unmarshal(m, b)
-}
-func (m *StreamPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamPrefixResponse.Marshal(b, m, deterministic)
-}
-func (m *StreamPrefixResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamPrefixResponse.Merge(m, src)
-}
-func (m *StreamPrefixResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamPrefixResponse.Size(m)
-}
-func (m *StreamPrefixResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamPrefixResponse.DiscardUnknown(m)
+func (m *Webhook) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
 }
 
-var xxx_messageInfo_StreamPrefixResponse proto.InternalMessageInfo
-
-func (m *StreamPrefixResponse) GetObject() *ObjectDetail {
+func (m *Webhook) GetEventTypes() []WebhookEventType {
 	if m != nil {
-		return m.Object
+		return m.EventTypes
 	}
 	return nil
 }
 
-type SetRequest struct {
-	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
 }
 
-func (m *SetRequest) Reset()         { *m = SetRequest{} }
-func (m *SetRequest) String() string { return proto.CompactTextString(m) }
-func (*SetRequest) ProtoMessage()    {}
-func (*SetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+//WebhookPayload is the json body POSTed to a webhook
+type WebhookPayload struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType            WebhookEventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=api.WebhookEventType" json:"event_type,omitempty"`
+	TimestampUnix        int64            `protobuf:"varint,3,opt,name=timestamp_unix,json=timestampUnix,proto3" json:"timestamp_unix,omitempty"`
+	Object               *ObjectDetail    `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	GeofenceEvent        *GeofenceEvent   `protobuf:"bytes,5,opt,name=geofence_event,json=geofenceEvent,proto3" json:"geofence_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
+func (m *WebhookPayload) Reset()         { *m = WebhookPayload{} }
+func (m *WebhookPayload) String() string { return proto.CompactTextString(m) }
+func (*WebhookPayload) ProtoMessage()    {}
+func (*WebhookPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
-func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
+
+func (m *WebhookPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookPayload.Unmarshal(m, b)
 }
-func (m *SetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetRequest.Merge(m, src)
+func (m *WebhookPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookPayload.Marshal(b, m, deterministic)
 }
-func (m *SetRequest) XXX_Size() int {
-	return xxx_messageInfo_SetRequest.Size(m)
+func (m *WebhookPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookPayload.Merge(m, src)
 }
-func (m *SetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetRequest.DiscardUnknown(m)
+func (m *WebhookPayload) XXX_Size() int {
+	return xxx_messageInfo_WebhookPayload.Size(m)
+}
+func (m *WebhookPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetRequest proto.InternalMessageInfo
+var xxx_messageInfo_WebhookPayload proto.InternalMessageInfo
 
-func (m *SetRequest) GetObject() *Object {
+func (m *WebhookPayload) GetId() string {
 	if m != nil {
-		return m.Object
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type SetResponse struct {
-	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *WebhookPayload) GetEventType() WebhookEventType {
+	if m != nil {
+		return m.EventType
+	}
+	return WebhookEventType_ObjectUpdated
 }
 
-func (m *SetResponse) Reset()         { *m = SetResponse{} }
-func (m *SetResponse) String() string { return proto.CompactTextString(m) }
-func (*SetResponse) ProtoMessage()    {}
-func (*SetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *WebhookPayload) GetTimestampUnix() int64 {
+	if m != nil {
+		return m.TimestampUnix
+	}
+	return 0
 }
 
-func (m *SetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
-}
-func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
-}
-func (m *SetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetResponse.Merge(m, src)
-}
-func (m *SetResponse) XXX_Size() int {
-	return xxx_messageInfo_SetResponse.Size(m)
-}
-func (m *SetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetResponse.DiscardUnknown(m)
+func (m *WebhookPayload) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
 }
 
-var xxx_messageInfo_SetResponse proto.InternalMessageInfo
-
-func (m *SetResponse) GetObject() *ObjectDetail {
+func (m *WebhookPayload) GetGeofenceEvent() *GeofenceEvent {
 	if m != nil {
-		return m.Object
+		return m.GeofenceEvent
 	}
 	return nil
 }
 
-type GetKeysRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//A WebhookDelivery is a payload that could not be delivered after every retry
+type WebhookDelivery struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId            string          `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Payload              *WebhookPayload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts             int64           `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string          `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FailedUnix           int64           `protobuf:"varint,6,opt,name=failed_unix,json=failedUnix,proto3" json:"failed_unix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
-func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetKeysRequest) ProtoMessage()    {}
-func (*GetKeysRequest) Descriptor() ([]byte, []int) {
+func (m *WebhookDelivery) Reset()         { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()    {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
-func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDelivery.Unmarshal(m, b)
 }
-func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
 }
-func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysRequest.Merge(m, src)
+func (m *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(m, src)
 }
-func (m *GetKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetKeysRequest.Size(m)
+func (m *WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_WebhookDelivery.Size(m)
 }
-func (m *GetKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
 
-type GetKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WebhookDelivery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
-func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKeysResponse) ProtoMessage()    {}
-func (*GetKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
-}
-
-func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
-}
-func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
-}
-func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeysResponse.Merge(m, src)
-}
-func (m *GetKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKeysResponse.Size(m)
-}
-func (m *GetKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
+func (m *WebhookDelivery) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
-
-func (m *GetKeysResponse) GetKeys() []string {
+func (m *WebhookDelivery) GetPayload() *WebhookPayload {
 	if m != nil {
-		return m.Keys
+		return m.Payload
 	}
 	return nil
 }
 
-type GetPrefixKeysRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
-func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysRequest) ProtoMessage()    {}
-func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *WebhookDelivery) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
 }
 
-func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
-}
-func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
-}
-func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
-}
-func (m *GetPrefixKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
-}
-func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
+func (m *WebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
-
-func (m *GetPrefixKeysRequest) GetPrefix() string {
+func (m *WebhookDelivery) GetFailedUnix() int64 {
 	if m != nil {
-		return m.Prefix
+		return m.FailedUnix
 	}
-	return ""
+	return 0
 }
 
-type GetPrefixKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//MetadataFilter is a set of conditions on an objects metadata. An object must satisfy every condition to match the filter
+type MetadataFilter struct {
+	Conditions           []*MetadataCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
-func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixKeysResponse) ProtoMessage()    {}
-func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *MetadataFilter) Reset()         { *m = MetadataFilter{} }
+func (m *MetadataFilter) String() string { return proto.CompactTextString(m) }
+func (*MetadataFilter) ProtoMessage()    {}
+func (*MetadataFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
-func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+func (m *MetadataFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetadataFilter.Unmarshal(m, b)
 }
-func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+func (m *MetadataFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetadataFilter.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+func (m *MetadataFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataFilter.Merge(m, src)
 }
-func (m *GetPrefixKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+func (m *MetadataFilter) XXX_Size() int {
+	return xxx_messageInfo_MetadataFilter.Size(m)
 }
-func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
+func (m *MetadataFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataFilter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_MetadataFilter proto.InternalMessageInfo
 
-func (m *GetPrefixKeysResponse) GetKeys() []string {
+func (m *MetadataFilter) GetConditions() []*MetadataCondition {
 	if m != nil {
-		return m.Keys
+		return m.Conditions
 	}
 	return nil
 }
 
-type GetRegexKeysRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+//MetadataCondition compares the value of a single metadata key
+type MetadataCondition struct {
+	Key                  string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator             MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=api.MetadataOperator" json:"operator,omitempty"`
+	Values               []string         `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
-func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysRequest) ProtoMessage()    {}
-func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *MetadataCondition) Reset()         { *m = MetadataCondition{} }
+func (m *MetadataCondition) String() string { return proto.CompactTextString(m) }
+func (*MetadataCondition) ProtoMessage()    {}
+func (*MetadataCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
+func (m *MetadataCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetadataCondition.Unmarshal(m, b)
 }
-func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+func (m *MetadataCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetadataCondition.Marshal(b, m, deterministic)
 }
-func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+func (m *MetadataCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataCondition.Merge(m, src)
 }
-func (m *GetRegexKeysRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+func (m *MetadataCondition) XXX_Size() int {
+	return xxx_messageInfo_MetadataCondition.Size(m)
 }
-func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
+func (m *MetadataCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataCondition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_MetadataCondition proto.InternalMessageInfo
 
-func (m *GetRegexKeysRequest) GetRegex() string {
+func (m *MetadataCondition) GetKey() string {
 	if m != nil {
-		return m.Regex
+		return m.Key
 	}
 	return ""
 }
 
-type GetRegexKeysResponse struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
-func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexKeysResponse) ProtoMessage()    {}
-func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
-}
-
-func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
-}
-func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
-}
-func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
-}
-func (m *GetRegexKeysResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
-}
-func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+func (m *MetadataCondition) GetOperator() MetadataOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return MetadataOperator_Equal
 }
 
-var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
-
-func (m *GetRegexKeysResponse) GetKeys() []string {
+func (m *MetadataCondition) GetValues() []string {
 	if m != nil {
-		return m.Keys
+		return m.Values
 	}
 	return nil
 }
 
-type GetRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type StreamRequest struct {
+	ClientId             string          `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	FromSequence         uint64          `protobuf:"varint,4,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
+func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRequest) ProtoMessage()    {}
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+func (m *StreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+func (m *StreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRequest.Merge(m, src)
 }
-func (m *GetRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRequest.Size(m)
+func (m *StreamRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRequest.Size(m)
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+func (m *StreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamRequest proto.InternalMessageInfo
 
-func (m *GetRequest) GetKeys() []string {
+func (m *StreamRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamRequest) GetKeys() []string {
 	if m != nil {
 		return m.Keys
 	}
 	return nil
 }
 
-type GetResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *StreamRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func (m *GetResponse) Reset()         { *m = GetResponse{} }
-func (m *GetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()    {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *StreamRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
 }
 
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+type StreamResponse struct {
+	Object               *ObjectDetail   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Event                ObjectEventType `protobuf:"varint,2,opt,name=event,proto3,enum=api.ObjectEventType" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+
+func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
+func (m *StreamResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamResponse) ProtoMessage()    {}
+func (*StreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
+
+func (m *StreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamResponse.Unmarshal(m, b)
 }
-func (m *GetResponse) XXX_Size() int {
-	return xxx_messageInfo_GetResponse.Size(m)
+func (m *StreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamResponse.Marshal(b, m, deterministic)
 }
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *StreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamResponse.Merge(m, src)
+}
+func (m *StreamResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamResponse.Size(m)
+}
+func (m *StreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamResponse proto.InternalMessageInfo
 
-func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Objects
+		return m.Object
 	}
 	return nil
 }
 
-type GetRegexRequest struct {
-	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamResponse) GetEvent() ObjectEventType {
+	if m != nil {
+		return m.Event
+	}
+	return ObjectEventType_Set
 }
 
-func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
-func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRegexRequest) ProtoMessage()    {}
-func (*GetRegexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+type StreamRegexRequest struct {
+	ClientId             string          `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Regex                string          `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	FromSequence         uint64          `protobuf:"varint,4,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+func (m *StreamRegexRequest) Reset()         { *m = StreamRegexRequest{} }
+func (m *StreamRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamRegexRequest) ProtoMessage()    {}
+func (*StreamRegexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
-func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+
+func (m *StreamRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRegexRequest.Unmarshal(m, b)
 }
-func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+func (m *StreamRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRegexRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRegexRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRegexRequest.Size(m)
+func (m *StreamRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRegexRequest.Merge(m, src)
 }
-func (m *GetRegexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+func (m *StreamRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamRegexRequest.Size(m)
+}
+func (m *StreamRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRegexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamRegexRequest proto.InternalMessageInfo
 
-func (m *GetRegexRequest) GetRegex() string {
+func (m *StreamRegexRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamRegexRequest) GetRegex() string {
 	if m != nil {
 		return m.Regex
 	}
 	return ""
 }
 
-type GetRegexResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *StreamRegexRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
-func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRegexResponse) ProtoMessage()    {}
-func (*GetRegexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *StreamRegexRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
 }
 
-func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+type StreamRegexResponse struct {
+	Object               *ObjectDetail   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Event                ObjectEventType `protobuf:"varint,2,opt,name=event,proto3,enum=api.ObjectEventType" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+
+func (m *StreamRegexResponse) Reset()         { *m = StreamRegexResponse{} }
+func (m *StreamRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamRegexResponse) ProtoMessage()    {}
+func (*StreamRegexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
-func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+
+func (m *StreamRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamRegexResponse.Unmarshal(m, b)
 }
-func (m *GetRegexResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRegexResponse.Size(m)
+func (m *StreamRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamRegexResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRegexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+func (m *StreamRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRegexResponse.Merge(m, src)
+}
+func (m *StreamRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamRegexResponse.Size(m)
+}
+func (m *StreamRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRegexResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamRegexResponse proto.InternalMessageInfo
 
-func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamRegexResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Objects
+		return m.Object
 	}
 	return nil
 }
 
-type GetPrefixRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamRegexResponse) GetEvent() ObjectEventType {
+	if m != nil {
+		return m.Event
+	}
+	return ObjectEventType_Set
 }
 
-func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
-func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixRequest) ProtoMessage()    {}
-func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+type StreamPrefixRequest struct {
+	ClientId             string          `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Prefix               string          `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	FromSequence         uint64          `protobuf:"varint,4,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+func (m *StreamPrefixRequest) Reset()         { *m = StreamPrefixRequest{} }
+func (m *StreamPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPrefixRequest) ProtoMessage()    {}
+func (*StreamPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
-func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+
+func (m *StreamPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPrefixRequest.Unmarshal(m, b)
 }
-func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+func (m *StreamPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPrefixRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixRequest.Size(m)
+func (m *StreamPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPrefixRequest.Merge(m, src)
 }
-func (m *GetPrefixRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+func (m *StreamPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPrefixRequest.Size(m)
+}
+func (m *StreamPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPrefixRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamPrefixRequest proto.InternalMessageInfo
 
-func (m *GetPrefixRequest) GetPrefix() string {
+func (m *StreamPrefixRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamPrefixRequest) GetPrefix() string {
 	if m != nil {
 		return m.Prefix
 	}
 	return ""
 }
 
-type GetPrefixResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *StreamPrefixRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
-func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPrefixResponse) ProtoMessage()    {}
-func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *StreamPrefixRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
 }
 
-func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
+type StreamPrefixResponse struct {
+	Object               *ObjectDetail   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Event                ObjectEventType `protobuf:"varint,2,opt,name=event,proto3,enum=api.ObjectEventType" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
+
+func (m *StreamPrefixResponse) Reset()         { *m = StreamPrefixResponse{} }
+func (m *StreamPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamPrefixResponse) ProtoMessage()    {}
+func (*StreamPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
-func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
+
+func (m *StreamPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPrefixResponse.Unmarshal(m, b)
 }
-func (m *GetPrefixResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPrefixResponse.Size(m)
+func (m *StreamPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPrefixResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPrefixResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+func (m *StreamPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPrefixResponse.Merge(m, src)
+}
+func (m *StreamPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamPrefixResponse.Size(m)
+}
+func (m *StreamPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPrefixResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamPrefixResponse proto.InternalMessageInfo
 
-func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+func (m *StreamPrefixResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Objects
+		return m.Object
 	}
 	return nil
 }
 
-type DeleteRequest struct {
-	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamPrefixResponse) GetEvent() ObjectEventType {
+	if m != nil {
+		return m.Event
+	}
+	return ObjectEventType_Set
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+type StreamBoundRequest struct {
+	ClientId             string          `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Circle               *Bound          `protobuf:"bytes,2,opt,name=circle,proto3" json:"circle,omitempty"`
+	Polygon              *Polygon        `protobuf:"bytes,3,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *StreamBoundRequest) Reset()         { *m = StreamBoundRequest{} }
+func (m *StreamBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBoundRequest) ProtoMessage()    {}
+func (*StreamBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+
+func (m *StreamBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBoundRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *StreamBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBoundRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *StreamBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBoundRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *StreamBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamBoundRequest.Size(m)
+}
+func (m *StreamBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBoundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamBoundRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetKeys() []string {
+func (m *StreamBoundRequest) GetClientId() string {
 	if m != nil {
-		return m.Keys
+		return m.ClientId
 	}
-	return nil
+	return ""
 }
 
-type DeleteResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamBoundRequest) GetCircle() *Bound {
+	if m != nil {
+		return m.Circle
+	}
+	return nil
 }
 
-func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
-func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteResponse) ProtoMessage()    {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *StreamBoundRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
 }
 
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
-}
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
-}
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
-}
-func (m *DeleteResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteResponse.Size(m)
-}
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+func (m *StreamBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
 }
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
-
-type ScanBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Keys                 []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type StreamBoundResponse struct {
+	Object               *ObjectDetail   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Event                ObjectEventType `protobuf:"varint,2,opt,name=event,proto3,enum=api.ObjectEventType" json:"event,omitempty"`
+	Left                 bool            `protobuf:"varint,3,opt,name=left,proto3" json:"left,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
-func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundRequest) ProtoMessage()    {}
-func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+func (m *StreamBoundResponse) Reset()         { *m = StreamBoundResponse{} }
+func (m *StreamBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamBoundResponse) ProtoMessage()    {}
+func (*StreamBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 
-func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+func (m *StreamBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBoundResponse.Unmarshal(m, b)
 }
-func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+func (m *StreamBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBoundResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+func (m *StreamBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBoundResponse.Merge(m, src)
 }
-func (m *ScanBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundRequest.Size(m)
+func (m *StreamBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamBoundResponse.Size(m)
 }
-func (m *ScanBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+func (m *StreamBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBoundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamBoundResponse proto.InternalMessageInfo
 
-func (m *ScanBoundRequest) GetBound() *Bound {
+func (m *StreamBoundResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Bound
+		return m.Object
 	}
 	return nil
 }
 
-func (m *ScanBoundRequest) GetKeys() []string {
+func (m *StreamBoundResponse) GetEvent() ObjectEventType {
 	if m != nil {
-		return m.Keys
+		return m.Event
 	}
-	return nil
+	return ObjectEventType_Set
 }
 
-type ScanBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *StreamBoundResponse) GetLeft() bool {
+	if m != nil {
+		return m.Left
+	}
+	return false
 }
 
-func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
-func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanBoundResponse) ProtoMessage()    {}
-func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+type SetRequest struct {
+	Object               *Object       `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Precondition         *Precondition `protobuf:"bytes,2,opt,name=precondition,proto3" json:"precondition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+func (m *SetRequest) Reset()         { *m = SetRequest{} }
+func (m *SetRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRequest) ProtoMessage()    {}
+func (*SetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
-func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+
+func (m *SetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRequest.Unmarshal(m, b)
 }
-func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+func (m *SetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanBoundResponse.Size(m)
+func (m *SetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRequest.Merge(m, src)
 }
-func (m *ScanBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+func (m *SetRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRequest.Size(m)
+}
+func (m *SetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetRequest proto.InternalMessageInfo
 
-func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *SetRequest) GetObject() *Object {
 	if m != nil {
-		return m.Objects
+		return m.Object
 	}
 	return nil
 }
 
-type ScanPrefixBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SetRequest) GetPrecondition() *Precondition {
+	if m != nil {
+		return m.Precondition
+	}
+	return nil
 }
 
-func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
-func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundRequest) ProtoMessage()    {}
-func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+//Precondition is a condition the stored object must meet for a write to be applied
+type Precondition struct {
+	ExpectedVersion        uint64   `protobuf:"varint,1,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IfNewerThanUpdatedUnix bool     `protobuf:"varint,2,opt,name=if_newer_than_updated_unix,json=ifNewerThanUpdatedUnix,proto3" json:"if_newer_than_updated_unix,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+func (m *Precondition) Reset()         { *m = Precondition{} }
+func (m *Precondition) String() string { return proto.CompactTextString(m) }
+func (*Precondition) ProtoMessage()    {}
+func (*Precondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
-func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+
+func (m *Precondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Precondition.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+func (m *Precondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Precondition.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+func (m *Precondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Precondition.Merge(m, src)
 }
-func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+func (m *Precondition) XXX_Size() int {
+	return xxx_messageInfo_Precondition.Size(m)
+}
+func (m *Precondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Precondition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_Precondition proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+func (m *Precondition) GetExpectedVersion() uint64 {
 	if m != nil {
-		return m.Bound
+		return m.ExpectedVersion
 	}
-	return nil
+	return 0
 }
 
-func (m *ScanPrefixBoundRequest) GetPrefix() string {
+func (m *Precondition) GetIfNewerThanUpdatedUnix() bool {
 	if m != nil {
-		return m.Prefix
+		return m.IfNewerThanUpdatedUnix
 	}
-	return ""
+	return false
 }
 
-type ScanPrefixBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type SetStreamRequest struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Object               *Object       `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Precondition         *Precondition `protobuf:"bytes,3,opt,name=precondition,proto3" json:"precondition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
-func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanPrefixBoundResponse) ProtoMessage()    {}
-func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+func (m *SetStreamRequest) Reset()         { *m = SetStreamRequest{} }
+func (m *SetStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStreamRequest) ProtoMessage()    {}
+func (*SetStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+func (m *SetStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetStreamRequest.Unmarshal(m, b)
 }
-func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+func (m *SetStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+func (m *SetStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamRequest.Merge(m, src)
 }
-func (m *ScanPrefixBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+func (m *SetStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_SetStreamRequest.Size(m)
 }
-func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+func (m *SetStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetStreamRequest proto.InternalMessageInfo
 
-func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+func (m *SetStreamRequest) GetId() string {
 	if m != nil {
-		return m.Objects
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SetStreamRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
 	}
 	return nil
 }
 
-type ScanRegexBoundRequest struct {
-	Bound                *Bound   `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
-	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+func (m *SetStreamRequest) GetPrecondition() *Precondition {
+	if m != nil {
+		return m.Precondition
+	}
+	return nil
+}
+
+type SetStreamResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Sequence             uint64   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Code                 uint32   `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Version              uint64   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Skipped              bool     `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
-func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundRequest) ProtoMessage()    {}
-func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+func (m *SetStreamResponse) Reset()         { *m = SetStreamResponse{} }
+func (m *SetStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStreamResponse) ProtoMessage()    {}
+func (*SetStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+func (m *SetStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetStreamResponse.Unmarshal(m, b)
 }
-func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+func (m *SetStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetStreamResponse.Marshal(b, m, deterministic)
 }
-func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+func (m *SetStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamResponse.Merge(m, src)
 }
-func (m *ScanRegexBoundRequest) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+func (m *SetStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_SetStreamResponse.Size(m)
 }
-func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+func (m *SetStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetStreamResponse proto.InternalMessageInfo
 
-func (m *ScanRegexBoundRequest) GetBound() *Bound {
+func (m *SetStreamResponse) GetId() string {
 	if m != nil {
-		return m.Bound
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *ScanRegexBoundRequest) GetRegex() string {
+func (m *SetStreamResponse) GetKey() string {
 	if m != nil {
-		return m.Regex
+		return m.Key
 	}
 	return ""
 }
 
-type ScanRegexBoundResponse struct {
-	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *SetStreamResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
-func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
-func (*ScanRegexBoundResponse) ProtoMessage()    {}
-func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+func (m *SetStreamResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
 }
 
-func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+func (m *SetStreamResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+
+func (m *SetStreamResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
-func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+
+func (m *SetStreamResponse) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
 }
-func (m *ScanRegexBoundResponse) XXX_Size() int {
-	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+
+type BatchSetRequest struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+
+func (m *BatchSetRequest) Reset()         { *m = BatchSetRequest{} }
+func (m *BatchSetRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchSetRequest) ProtoMessage()    {}
+func (*BatchSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+func (m *BatchSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchSetRequest.Unmarshal(m, b)
+}
+func (m *BatchSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchSetRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSetRequest.Merge(m, src)
+}
+func (m *BatchSetRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchSetRequest.Size(m)
+}
+func (m *BatchSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSetRequest.DiscardUnknown(m)
+}
 
-func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+var xxx_messageInfo_BatchSetRequest proto.InternalMessageInfo
+
+func (m *BatchSetRequest) GetObjects() []*Object {
 	if m != nil {
 		return m.Objects
 	}
 	return nil
 }
 
-type GetPointRequest struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+type BatchSetResponse struct {
+	Objects              []*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
 
-func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
-func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPointRequest) ProtoMessage()    {}
-func (*GetPointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+func (m *BatchSetResponse) Reset()         { *m = BatchSetResponse{} }
+func (m *BatchSetResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchSetResponse) ProtoMessage()    {}
+func (*BatchSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+func (m *BatchSetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchSetResponse.Unmarshal(m, b)
 }
-func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+func (m *BatchSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchSetResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPointRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointRequest.Merge(m, src)
+func (m *BatchSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSetResponse.Merge(m, src)
 }
-func (m *GetPointRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPointRequest.Size(m)
+func (m *BatchSetResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchSetResponse.Size(m)
 }
-func (m *GetPointRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+func (m *BatchSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchSetResponse proto.InternalMessageInfo
 
-func (m *GetPointRequest) GetAddress() string {
+func (m *BatchSetResponse) GetObjects() []*ObjectDetail {
 	if m != nil {
-		return m.Address
+		return m.Objects
 	}
-	return ""
+	return nil
 }
 
-type GetPointResponse struct {
-	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetResponse struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Skipped              bool          `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
-func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPointResponse) ProtoMessage()    {}
-func (*GetPointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *SetResponse) Reset()         { *m = SetResponse{} }
+func (m *SetResponse) String() string { return proto.CompactTextString(m) }
+func (*SetResponse) ProtoMessage()    {}
+func (*SetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+func (m *SetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetResponse.Unmarshal(m, b)
 }
-func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+func (m *SetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPointResponse.Merge(m, src)
+func (m *SetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResponse.Merge(m, src)
 }
-func (m *GetPointResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPointResponse.Size(m)
+func (m *SetResponse) XXX_Size() int {
+	return xxx_messageInfo_SetResponse.Size(m)
 }
-func (m *GetPointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+func (m *SetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetResponse proto.InternalMessageInfo
 
-func (m *GetPointResponse) GetPoint() *Point {
+func (m *SetResponse) GetObject() *ObjectDetail {
 	if m != nil {
-		return m.Point
+		return m.Object
 	}
 	return nil
 }
 
-type PingRequest struct {
+func (m *SetResponse) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+//ObjectResult is a single result of a streamed listing
+type ObjectResult struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	PageToken            string        `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ObjectResult) Reset()         { *m = ObjectResult{} }
+func (m *ObjectResult) String() string { return proto.CompactTextString(m) }
+func (*ObjectResult) ProtoMessage()    {}
+func (*ObjectResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+}
+
+func (m *ObjectResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectResult.Unmarshal(m, b)
+}
+func (m *ObjectResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectResult.Marshal(b, m, deterministic)
+}
+func (m *ObjectResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectResult.Merge(m, src)
+}
+func (m *ObjectResult) XXX_Size() int {
+	return xxx_messageInfo_ObjectResult.Size(m)
+}
+func (m *ObjectResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectResult proto.InternalMessageInfo
+
+func (m *ObjectResult) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *ObjectResult) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetKeysRequest struct {
+	PageSize             int32    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingRequest) Reset()         { *m = PingRequest{} }
-func (m *PingRequest) String() string { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()    {}
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+func (m *GetKeysRequest) Reset()         { *m = GetKeysRequest{} }
+func (m *GetKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeysRequest) ProtoMessage()    {}
+func (*GetKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 
-func (m *PingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+func (m *GetKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysRequest.Unmarshal(m, b)
 }
-func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+func (m *GetKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysRequest.Marshal(b, m, deterministic)
 }
-func (m *PingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingRequest.Merge(m, src)
+func (m *GetKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysRequest.Merge(m, src)
 }
-func (m *PingRequest) XXX_Size() int {
-	return xxx_messageInfo_PingRequest.Size(m)
+func (m *GetKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeysRequest.Size(m)
 }
-func (m *PingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+func (m *GetKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetKeysRequest proto.InternalMessageInfo
 
-type PingResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+func (m *GetKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingResponse) Reset()         { *m = PingResponse{} }
-func (m *PingResponse) String() string { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()    {}
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+func (m *GetKeysResponse) Reset()         { *m = GetKeysResponse{} }
+func (m *GetKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeysResponse) ProtoMessage()    {}
+func (*GetKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (m *PingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+func (m *GetKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeysResponse.Unmarshal(m, b)
 }
-func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+func (m *GetKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeysResponse.Marshal(b, m, deterministic)
 }
-func (m *PingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingResponse.Merge(m, src)
+func (m *GetKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeysResponse.Merge(m, src)
 }
-func (m *PingResponse) XXX_Size() int {
-	return xxx_messageInfo_PingResponse.Size(m)
+func (m *GetKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeysResponse.Size(m)
 }
-func (m *PingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+func (m *GetKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetKeysResponse proto.InternalMessageInfo
 
-func (m *PingResponse) GetOk() bool {
+func (m *GetKeysResponse) GetKeys() []string {
 	if m != nil {
-		return m.Ok
+		return m.Keys
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
-	proto.RegisterType((*Point)(nil), "api.Point")
-	proto.RegisterType((*Bound)(nil), "api.Bound")
-	proto.RegisterType((*Object)(nil), "api.Object")
-	proto.RegisterMapType((map[string]string)(nil), "api.Object.MetadataEntry")
-	proto.RegisterType((*ObjectTracking)(nil), "api.ObjectTracking")
-	proto.RegisterType((*ObjectTracker)(nil), "api.ObjectTracker")
-	proto.RegisterType((*Directions)(nil), "api.Directions")
-	proto.RegisterType((*Address)(nil), "api.Address")
-	proto.RegisterType((*TrackerEvent)(nil), "api.TrackerEvent")
-	proto.RegisterType((*ObjectDetail)(nil), "api.ObjectDetail")
-	proto.RegisterType((*StreamRequest)(nil), "api.StreamRequest")
-	proto.RegisterType((*StreamResponse)(nil), "api.StreamResponse")
-	proto.RegisterType((*StreamRegexRequest)(nil), "api.StreamRegexRequest")
-	proto.RegisterType((*StreamRegexResponse)(nil), "api.StreamRegexResponse")
-	proto.RegisterType((*StreamPrefixRequest)(nil), "api.StreamPrefixRequest")
-	proto.RegisterType((*StreamPrefixResponse)(nil), "api.StreamPrefixResponse")
-	proto.RegisterType((*SetRequest)(nil), "api.SetRequest")
-	proto.RegisterType((*SetResponse)(nil), "api.SetResponse")
-	proto.RegisterType((*GetKeysRequest)(nil), "api.GetKeysRequest")
-	proto.RegisterType((*GetKeysResponse)(nil), "api.GetKeysResponse")
-	proto.RegisterType((*GetPrefixKeysRequest)(nil), "api.GetPrefixKeysRequest")
-	proto.RegisterType((*GetPrefixKeysResponse)(nil), "api.GetPrefixKeysResponse")
-	proto.RegisterType((*GetRegexKeysRequest)(nil), "api.GetRegexKeysRequest")
-	proto.RegisterType((*GetRegexKeysResponse)(nil), "api.GetRegexKeysResponse")
-	proto.RegisterType((*GetRequest)(nil), "api.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "api.GetResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetResponse.ObjectsEntry")
-	proto.RegisterType((*GetRegexRequest)(nil), "api.GetRegexRequest")
-	proto.RegisterType((*GetRegexResponse)(nil), "api.GetRegexResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetRegexResponse.ObjectsEntry")
-	proto.RegisterType((*GetPrefixRequest)(nil), "api.GetPrefixRequest")
-	proto.RegisterType((*GetPrefixResponse)(nil), "api.GetPrefixResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetPrefixResponse.ObjectsEntry")
-	proto.RegisterType((*DeleteRequest)(nil), "api.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "api.DeleteResponse")
-	proto.RegisterType((*ScanBoundRequest)(nil), "api.ScanBoundRequest")
-	proto.RegisterType((*ScanBoundResponse)(nil), "api.ScanBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanBoundResponse.ObjectsEntry")
-	proto.RegisterType((*ScanPrefixBoundRequest)(nil), "api.ScanPrefixBoundRequest")
-	proto.RegisterType((*ScanPrefixBoundResponse)(nil), "api.ScanPrefixBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixBoundResponse.ObjectsEntry")
-	proto.RegisterType((*ScanRegexBoundRequest)(nil), "api.ScanRegexBoundRequest")
-	proto.RegisterType((*ScanRegexBoundResponse)(nil), "api.ScanRegexBoundResponse")
-	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoundResponse.ObjectsEntry")
-	proto.RegisterType((*GetPointRequest)(nil), "api.GetPointRequest")
-	proto.RegisterType((*GetPointResponse)(nil), "api.GetPointResponse")
-	proto.RegisterType((*PingRequest)(nil), "api.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "api.PingResponse")
+func (m *GetKeysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+type GetPrefixKeysRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0x13, 0xc7,
-	0x12, 0xd7, 0x5a, 0x96, 0x2c, 0xb5, 0xfe, 0x78, 0x3d, 0xfe, 0x83, 0x2c, 0xde, 0x03, 0xbd, 0xe5,
-	0x01, 0x06, 0x3f, 0xdb, 0x3c, 0x11, 0x08, 0x04, 0x53, 0x05, 0xc2, 0x2e, 0x91, 0xa2, 0x08, 0xd4,
-	0xda, 0xa9, 0x54, 0x52, 0xa9, 0xa8, 0xd6, 0xda, 0x89, 0x98, 0x58, 0xda, 0x55, 0x76, 0x47, 0xc6,
-	0x22, 0x95, 0x0f, 0x91, 0x43, 0xce, 0xa9, 0x1c, 0x72, 0x4a, 0xe5, 0x90, 0x7b, 0xf2, 0x59, 0xa8,
-	0xf0, 0x49, 0x52, 0xf3, 0x67, 0x47, 0x33, 0xb2, 0x50, 0xec, 0x8b, 0x6f, 0x9a, 0xee, 0x5f, 0xf7,
-	0x74, 0xff, 0xa6, 0xbb, 0x67, 0x56, 0x90, 0xf7, 0xfa, 0x64, 0xb3, 0x1f, 0x85, 0x34, 0x44, 0x69,
-	0xaf, 0x4f, 0xaa, 0x77, 0x3b, 0x84, 0xbe, 0x1a, 0x1c, 0x6c, 0xb6, 0xc3, 0xde, 0x56, 0xef, 0x35,
-	0xa1, 0x87, 0xe1, 0xeb, 0xad, 0x4e, 0xb8, 0xc1, 0x11, 0x1b, 0x47, 0x5e, 0x97, 0xf8, 0x1e, 0x0d,
-	0xa3, 0x78, 0x4b, 0xfd, 0x14, 0xc6, 0xce, 0x3a, 0x64, 0x5e, 0x86, 0x24, 0xa0, 0xc8, 0x86, 0x74,
-	0xd7, 0xa3, 0x15, 0xab, 0x66, 0xad, 0x59, 0x2e, 0xfb, 0xc9, 0x25, 0x61, 0x50, 0x99, 0x91, 0x92,
-	0x30, 0x70, 0x9e, 0x40, 0xa6, 0x11, 0x0e, 0x02, 0x1f, 0x39, 0x90, 0x6d, 0xe3, 0x80, 0xe2, 0x88,
-	0xe3, 0x0b, 0x75, 0xd8, 0x64, 0xe1, 0x70, 0x47, 0xae, 0xd4, 0xa0, 0x15, 0xc8, 0x46, 0x9e, 0x4f,
-	0x06, 0xb1, 0xf4, 0x20, 0x57, 0xce, 0x2f, 0x69, 0xc8, 0xbe, 0x38, 0xf8, 0x06, 0xb7, 0x29, 0x72,
-	0x20, 0x7d, 0x88, 0x87, 0xdc, 0x47, 0xbe, 0x61, 0xbf, 0x7b, 0x7b, 0xb9, 0x08, 0xf0, 0xd5, 0xe6,
-	0x77, 0xff, 0xff, 0x5f, 0xbd, 0x7e, 0xe7, 0xfb, 0xff, 0xba, 0x4c, 0x89, 0xd6, 0x20, 0xd3, 0x67,
-	0x7e, 0xb9, 0x17, 0x63, 0xa7, 0x46, 0xf6, 0xdd, 0xdb, 0xcb, 0x33, 0x35, 0xcb, 0x15, 0x00, 0x74,
-	0x49, 0x6d, 0x98, 0xae, 0x59, 0x6b, 0x69, 0xa1, 0xb6, 0x53, 0xc9, 0xc6, 0x68, 0x0b, 0x72, 0x34,
-	0xf2, 0xda, 0x87, 0x24, 0xe8, 0x54, 0x66, 0xb9, 0xb3, 0x45, 0xee, 0x4c, 0x04, 0xb3, 0x2f, 0x55,
-	0xae, 0x02, 0xa1, 0x3b, 0x90, 0xeb, 0x61, 0xea, 0xf9, 0x1e, 0xf5, 0x2a, 0x99, 0x5a, 0x7a, 0xad,
-	0x50, 0x5f, 0xd5, 0x0c, 0x36, 0x9f, 0x4b, 0xdd, 0x6e, 0x40, 0xa3, 0xa1, 0xab, 0xa0, 0xe8, 0x32,
-	0x14, 0x3a, 0x98, 0xb6, 0x3c, 0xdf, 0x8f, 0x70, 0x1c, 0x57, 0xb2, 0x35, 0x6b, 0x2d, 0xe7, 0x42,
-	0x07, 0xd3, 0xc7, 0x42, 0x82, 0xfe, 0x03, 0x45, 0x06, 0xa0, 0xa4, 0x87, 0xdf, 0x84, 0x01, 0xae,
-	0xcc, 0x71, 0x04, 0x33, 0xda, 0x97, 0x22, 0x06, 0xc1, 0xc7, 0x7d, 0x12, 0xe1, 0xb8, 0x35, 0x08,
-	0xc8, 0x71, 0x25, 0xc7, 0x32, 0x72, 0x0b, 0x52, 0xf6, 0x69, 0x40, 0x8e, 0x19, 0x64, 0xd0, 0xf7,
-	0x3d, 0x8a, 0x7d, 0x01, 0xc9, 0x0b, 0x88, 0x94, 0x31, 0x48, 0xf5, 0x01, 0x94, 0x8c, 0x20, 0xd9,
-	0x91, 0x2a, 0xc2, 0x05, 0xbd, 0x4b, 0x90, 0x39, 0xf2, 0xba, 0x03, 0xcc, 0xe9, 0xcd, 0xbb, 0x62,
-	0xf1, 0xd1, 0xcc, 0x3d, 0xcb, 0x89, 0xa0, 0x6c, 0x32, 0x83, 0x6e, 0x41, 0x81, 0x46, 0xde, 0x11,
-	0xee, 0xb6, 0x7a, 0xa1, 0x8f, 0xb9, 0x97, 0x72, 0x7d, 0x9e, 0x53, 0xb2, 0xcf, 0xe5, 0xcf, 0x43,
-	0x1f, 0xbb, 0x40, 0xd5, 0x6f, 0xb4, 0x29, 0x29, 0xc7, 0x11, 0xab, 0x02, 0xc6, 0x20, 0x1a, 0xa7,
-	0x1c, 0x47, 0xae, 0xc2, 0x38, 0x7f, 0x58, 0x50, 0x32, 0x74, 0x68, 0x1b, 0x16, 0xa8, 0x17, 0x31,
-	0xba, 0x42, 0x2e, 0x6f, 0x4d, 0x2b, 0x98, 0x79, 0x01, 0x15, 0x1e, 0x9e, 0xe1, 0x21, 0xba, 0x01,
-	0x36, 0xf7, 0xdd, 0xf2, 0x49, 0x84, 0xdb, 0x94, 0x84, 0x81, 0xa8, 0xc6, 0x9c, 0x3b, 0xcf, 0xe5,
-	0x3b, 0x4a, 0x8c, 0xae, 0x42, 0x39, 0x81, 0xc6, 0xd4, 0x0b, 0xda, 0x98, 0x57, 0x51, 0xce, 0x2d,
-	0x49, 0xa0, 0x10, 0xa2, 0x8b, 0x90, 0x17, 0x30, 0x4c, 0x3d, 0x5e, 0x45, 0x39, 0x19, 0xfe, 0x2e,
-	0xf5, 0x9c, 0x57, 0x00, 0x9a, 0xc7, 0xeb, 0x30, 0xff, 0x8a, 0xf6, 0xba, 0xfa, 0xde, 0x82, 0xf8,
-	0x32, 0x13, 0x6b, 0x40, 0x1b, 0xd2, 0xcc, 0xdb, 0x0c, 0x3f, 0x40, 0xf6, 0x93, 0x95, 0x90, 0x64,
-	0x9a, 0x45, 0x23, 0xea, 0x39, 0x21, 0x96, 0x85, 0xe2, 0xfc, 0x60, 0xc1, 0x5c, 0x52, 0x4e, 0x4b,
-	0x90, 0x89, 0xa9, 0x47, 0xb1, 0xf4, 0x2e, 0x16, 0xa8, 0x02, 0x73, 0x49, 0x05, 0x8a, 0xa3, 0x4d,
-	0x96, 0x4c, 0xd3, 0x0e, 0x07, 0xac, 0x1e, 0xb8, 0xe3, 0xbc, 0x9b, 0x2c, 0x59, 0x20, 0x6f, 0x48,
-	0x9f, 0xa7, 0x95, 0x77, 0xd9, 0x4f, 0xd6, 0xc4, 0x5c, 0x39, 0xac, 0x64, 0xb8, 0x50, 0xae, 0x10,
-	0x82, 0xd9, 0x36, 0xa1, 0x43, 0x5e, 0xdc, 0x79, 0x97, 0xff, 0x76, 0xfe, 0xb4, 0xa0, 0x28, 0x8f,
-	0x6d, 0xf7, 0x08, 0x07, 0x14, 0x5d, 0x81, 0xac, 0x38, 0x34, 0x39, 0x25, 0x0a, 0xda, 0xd9, 0xbb,
-	0x52, 0x85, 0xaa, 0x90, 0x53, 0x8c, 0x8b, 0x41, 0xa1, 0xd6, 0x6c, 0x77, 0x12, 0xc4, 0xc4, 0x4f,
-	0xce, 0x42, 0xae, 0xd0, 0x06, 0xe4, 0x15, 0xa9, 0xb2, 0x95, 0x45, 0x19, 0x8e, 0x48, 0x75, 0x47,
-	0x08, 0x7e, 0xb4, 0xa4, 0x87, 0x63, 0xea, 0xf5, 0xfa, 0xa2, 0x57, 0x32, 0x9c, 0xd0, 0x92, 0x92,
-	0xb2, 0x6e, 0x71, 0x7e, 0xb7, 0xa0, 0x28, 0x82, 0xdb, 0xc1, 0xd4, 0x23, 0xdd, 0xd3, 0xc5, 0x7f,
-	0xcd, 0xe4, 0xb9, 0x50, 0x2f, 0x72, 0x94, 0x3c, 0x9c, 0x11, 0xeb, 0x55, 0xc8, 0xa9, 0x86, 0x17,
-	0xb4, 0xab, 0x35, 0xba, 0x27, 0x6b, 0x0f, 0x47, 0x2d, 0xcc, 0x98, 0x8b, 0x2b, 0xb3, 0xbc, 0x59,
-	0x16, 0x92, 0xde, 0x52, 0x9c, 0xca, 0x72, 0x94, 0xab, 0xd8, 0x79, 0x04, 0xa5, 0x3d, 0x1a, 0x61,
-	0xaf, 0xe7, 0xe2, 0x6f, 0x07, 0x38, 0xa6, 0xac, 0x3e, 0xdb, 0x5d, 0x82, 0x03, 0xda, 0x22, 0xbe,
-	0x2c, 0x88, 0x9c, 0x10, 0x7c, 0xec, 0xb3, 0x53, 0x3b, 0xc4, 0x43, 0xd1, 0x8a, 0x79, 0x97, 0xff,
-	0x76, 0x1e, 0x40, 0x39, 0xf1, 0x10, 0xf7, 0xc3, 0x20, 0xc6, 0xe8, 0xc6, 0x58, 0xda, 0x0b, 0x5a,
-	0xda, 0x82, 0x99, 0x24, 0x79, 0xe7, 0x73, 0x40, 0x89, 0x71, 0x07, 0x1f, 0x9f, 0x2a, 0x86, 0x6b,
-	0x90, 0x89, 0x18, 0x58, 0x54, 0xe5, 0x84, 0x26, 0x16, 0x6a, 0xe7, 0x11, 0x2c, 0x1a, 0xae, 0xcf,
-	0x1e, 0xdc, 0x97, 0x89, 0x87, 0x97, 0x11, 0xfe, 0x9a, 0x9c, 0x2e, 0xba, 0x35, 0xc8, 0xf6, 0x39,
-	0xfa, 0xbd, 0xe1, 0x49, 0xbd, 0xf3, 0x18, 0x96, 0x4c, 0xef, 0x67, 0x0f, 0xf0, 0x3e, 0xc0, 0x1e,
-	0xa6, 0x49, 0x5c, 0xeb, 0x53, 0xaa, 0x4d, 0x5d, 0x75, 0x89, 0xe9, 0x3d, 0x28, 0x70, 0xd3, 0xb3,
-	0x6f, 0x6a, 0x43, 0xb9, 0x89, 0xd9, 0x70, 0x8c, 0xe5, 0xc6, 0xce, 0x55, 0x98, 0x57, 0x12, 0xe9,
-	0x2f, 0x29, 0x14, 0x4b, 0x2b, 0x94, 0x47, 0xb0, 0xd4, 0xc4, 0x54, 0x64, 0xab, 0x99, 0x6b, 0x94,
-	0x59, 0xff, 0x40, 0xd9, 0x3a, 0x2c, 0x8f, 0x79, 0x98, 0xb2, 0xdd, 0x43, 0x58, 0x6c, 0xb2, 0x0c,
-	0x3b, 0xd8, 0xd8, 0x4d, 0x95, 0x8f, 0x35, 0xbd, 0x7c, 0x6e, 0xf2, 0x68, 0x35, 0xf3, 0x29, 0x5b,
-	0xd5, 0x00, 0x9a, 0xa3, 0x73, 0x98, 0x84, 0xf8, 0xd1, 0x82, 0x42, 0x53, 0xe3, 0xfb, 0x43, 0x98,
-	0x13, 0x74, 0x0a, 0x58, 0xa1, 0xfe, 0x6f, 0x4e, 0xb8, 0x06, 0x91, 0xe4, 0xc7, 0xe2, 0x71, 0x90,
-	0xa0, 0xab, 0xcf, 0x93, 0x11, 0x13, 0xbf, 0xef, 0x42, 0xbe, 0xae, 0x5f, 0xc8, 0x13, 0x4f, 0x52,
-	0xbb, 0xa3, 0xef, 0xf3, 0xa3, 0x33, 0x9a, 0xef, 0xb4, 0x04, 0xfd, 0x64, 0x81, 0x3d, 0xb2, 0x95,
-	0x79, 0x6d, 0x8f, 0xe7, 0xe5, 0x8c, 0xf2, 0xd2, 0x70, 0xe7, 0x93, 0xdc, 0x36, 0x0f, 0xd0, 0x6c,
-	0xde, 0xd3, 0x17, 0xdb, 0xcf, 0x16, 0x2c, 0x68, 0xe6, 0x32, 0xc1, 0x87, 0xe3, 0x09, 0x5e, 0x49,
-	0x12, 0x34, 0x81, 0xe7, 0x93, 0xe1, 0x15, 0x28, 0xed, 0xe0, 0x2e, 0xa6, 0x78, 0x5a, 0xed, 0xd9,
-	0x50, 0x4e, 0x40, 0x22, 0x36, 0xe7, 0x29, 0xd8, 0x7b, 0x6d, 0x2f, 0xe0, 0x4f, 0xf1, 0xc4, 0xb2,
-	0x06, 0x99, 0x03, 0xb6, 0x36, 0x1e, 0xe4, 0x02, 0x21, 0x14, 0x13, 0x87, 0x3f, 0x23, 0x49, 0x73,
-	0x35, 0x9d, 0xa4, 0x13, 0xc0, 0xf3, 0x21, 0xc9, 0x85, 0x15, 0xb6, 0xb3, 0x38, 0x9f, 0x33, 0xe6,
-	0xbc, 0x62, 0x8e, 0x73, 0x55, 0x1c, 0xbf, 0x59, 0x70, 0xe1, 0x84, 0x53, 0x99, 0xfd, 0x93, 0xf1,
-	0xec, 0x6f, 0xa8, 0xec, 0x27, 0xc0, 0xcf, 0x87, 0x83, 0x17, 0xb0, 0xcc, 0xf6, 0xe7, 0x4d, 0x78,
-	0x46, 0x0a, 0x96, 0x8c, 0xfb, 0x36, 0xe9, 0xfe, 0x5f, 0x2d, 0xc1, 0xaa, 0xee, 0x51, 0xe6, 0xdf,
-	0x18, 0xcf, 0x7f, 0x4d, 0xe5, 0x7f, 0x12, 0x7d, 0x3e, 0xe9, 0xaf, 0xf3, 0x31, 0x27, 0x3e, 0x2f,
-	0x65, 0xe2, 0xda, 0xf3, 0xd6, 0x32, 0x9e, 0xb7, 0xce, 0x07, 0x62, 0x6c, 0x08, 0xb0, 0xcc, 0xa9,
-	0x96, 0x7c, 0x44, 0x9e, 0xfc, 0x5c, 0x15, 0x0a, 0xa7, 0x04, 0x85, 0x97, 0xec, 0xeb, 0x4f, 0xde,
-	0x89, 0x97, 0xa0, 0x28, 0x96, 0xd2, 0x41, 0x19, 0x66, 0xc2, 0x43, 0x6e, 0x9d, 0x73, 0x67, 0xc2,
-	0xc3, 0x9b, 0x0d, 0x80, 0xd1, 0x27, 0x0f, 0x2a, 0xc0, 0xdc, 0x4e, 0x44, 0x8e, 0x48, 0xd0, 0xb1,
-	0x53, 0x6c, 0xf1, 0x99, 0xd7, 0x65, 0x1f, 0x4c, 0xb6, 0x85, 0x4a, 0x90, 0x6f, 0x90, 0xf6, 0xb0,
-	0xdd, 0x65, 0xcb, 0x19, 0xa6, 0xdb, 0x8f, 0xbc, 0x20, 0x26, 0xd4, 0x4e, 0xd7, 0xff, 0x9a, 0x83,
-	0x4c, 0x13, 0x87, 0x3b, 0x0d, 0xb4, 0x01, 0xb3, 0x6c, 0x37, 0x64, 0x8b, 0xb8, 0x46, 0x71, 0x54,
-	0x17, 0x34, 0x89, 0xec, 0xfe, 0x14, 0xba, 0x09, 0xe9, 0x3d, 0x4c, 0x91, 0x78, 0xf2, 0x8e, 0x5e,
-	0x10, 0x55, 0x7b, 0x24, 0xd0, 0xb1, 0x4d, 0x85, 0x6d, 0x8e, 0x63, 0x9b, 0x06, 0xf6, 0x3e, 0xe4,
-	0x92, 0x49, 0x8f, 0x96, 0xc6, 0x06, 0xbf, 0xb0, 0x5a, 0x9e, 0x78, 0x1d, 0x38, 0x29, 0xb4, 0x0d,
-	0x79, 0x35, 0x43, 0xd1, 0xf2, 0xf8, 0x4c, 0x15, 0xc6, 0x2b, 0x93, 0x47, 0xad, 0x93, 0x42, 0x77,
-	0x61, 0x4e, 0xbe, 0x40, 0xd0, 0x62, 0x02, 0xd2, 0x2e, 0xfd, 0xea, 0x92, 0x29, 0x54, 0x76, 0xbb,
-	0x50, 0xd4, 0x2f, 0x79, 0x54, 0x31, 0xc2, 0xd3, 0x3d, 0xac, 0x4e, 0xd0, 0x28, 0x37, 0x4f, 0xa1,
-	0x64, 0xbc, 0x4b, 0xd0, 0xaa, 0x19, 0xa9, 0xee, 0xa8, 0x3a, 0x49, 0xa5, 0x3c, 0xdd, 0x86, 0xac,
-	0x98, 0xd5, 0x48, 0x7c, 0xe7, 0x1a, 0xd3, 0xbd, 0xba, 0x68, 0xc8, 0x94, 0xd1, 0x1d, 0xc8, 0x8a,
-	0x97, 0xa4, 0x34, 0x32, 0x1e, 0xf4, 0xd2, 0xc8, 0x7c, 0xa2, 0x3b, 0xa9, 0x5b, 0x16, 0xda, 0x81,
-	0x82, 0xf6, 0x40, 0x46, 0x17, 0x0c, 0x9c, 0x76, 0x66, 0x95, 0x93, 0x0a, 0xcd, 0x4b, 0x13, 0x8a,
-	0xfa, 0x33, 0x16, 0xe9, 0x68, 0xf3, 0xf8, 0x56, 0x27, 0x68, 0x34, 0x47, 0xdb, 0x90, 0x57, 0x17,
-	0x84, 0xac, 0x80, 0xf1, 0x4b, 0x4a, 0x56, 0xc0, 0x89, 0x7b, 0xc4, 0x49, 0xa1, 0x67, 0x50, 0x36,
-	0x07, 0x0c, 0xaa, 0x4e, 0x9c, 0x3a, 0xc2, 0xcf, 0xc5, 0x29, 0x13, 0xc9, 0x49, 0xa1, 0x4f, 0x60,
-	0x7e, 0x6c, 0x5a, 0xa3, 0x8b, 0x93, 0x67, 0xb8, 0x70, 0xf7, 0xaf, 0x69, 0x03, 0x5e, 0xf5, 0x85,
-	0xf8, 0x9b, 0x4c, 0x95, 0xa2, 0x3e, 0x8d, 0x46, 0x7d, 0x61, 0x8c, 0x1d, 0x27, 0xd5, 0xc8, 0x7c,
-	0x91, 0xf6, 0xfa, 0xe4, 0x20, 0xcb, 0xff, 0x6c, 0xbb, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xd3, 0x39, 0xf6, 0xb6, 0x13, 0x00, 0x00,
+func (m *GetPrefixKeysRequest) Reset()         { *m = GetPrefixKeysRequest{} }
+func (m *GetPrefixKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysRequest) ProtoMessage()    {}
+func (*GetPrefixKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+
+func (m *GetPrefixKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysRequest.Merge(m, src)
+}
+func (m *GetPrefixKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysRequest.Size(m)
+}
+func (m *GetPrefixKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixKeysRequest proto.InternalMessageInfo
+
+func (m *GetPrefixKeysRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GetPrefixKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetPrefixKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetPrefixKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrefixKeysResponse) Reset()         { *m = GetPrefixKeysResponse{} }
+func (m *GetPrefixKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixKeysResponse) ProtoMessage()    {}
+func (*GetPrefixKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+}
+
+func (m *GetPrefixKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixKeysResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixKeysResponse.Merge(m, src)
+}
+func (m *GetPrefixKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixKeysResponse.Size(m)
+}
+func (m *GetPrefixKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixKeysResponse proto.InternalMessageInfo
+
+func (m *GetPrefixKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetPrefixKeysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetRegexKeysRequest struct {
+	Regex                string   `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegexKeysRequest) Reset()         { *m = GetRegexKeysRequest{} }
+func (m *GetRegexKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysRequest) ProtoMessage()    {}
+func (*GetRegexKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+}
+
+func (m *GetRegexKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysRequest.Unmarshal(m, b)
+}
+func (m *GetRegexKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysRequest.Merge(m, src)
+}
+func (m *GetRegexKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysRequest.Size(m)
+}
+func (m *GetRegexKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexKeysRequest proto.InternalMessageInfo
+
+func (m *GetRegexKeysRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GetRegexKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetRegexKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetRegexKeysResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegexKeysResponse) Reset()         { *m = GetRegexKeysResponse{} }
+func (m *GetRegexKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexKeysResponse) ProtoMessage()    {}
+func (*GetRegexKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *GetRegexKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexKeysResponse.Unmarshal(m, b)
+}
+func (m *GetRegexKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexKeysResponse.Merge(m, src)
+}
+func (m *GetRegexKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexKeysResponse.Size(m)
+}
+func (m *GetRegexKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexKeysResponse proto.InternalMessageInfo
+
+func (m *GetRegexKeysResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetRegexKeysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetRequest struct {
+	Keys                 []string        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
+}
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+}
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
+}
+func (m *GetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResponse.Size(m)
+}
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+
+func (m *GetResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetRegexRequest struct {
+	Regex                string          `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetRegexRequest) Reset()         { *m = GetRegexRequest{} }
+func (m *GetRegexRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegexRequest) ProtoMessage()    {}
+func (*GetRegexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+
+func (m *GetRegexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexRequest.Unmarshal(m, b)
+}
+func (m *GetRegexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexRequest.Merge(m, src)
+}
+func (m *GetRegexRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegexRequest.Size(m)
+}
+func (m *GetRegexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexRequest proto.InternalMessageInfo
+
+func (m *GetRegexRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *GetRegexRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetRegexRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetRegexRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetRegexResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetRegexResponse) Reset()         { *m = GetRegexResponse{} }
+func (m *GetRegexResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegexResponse) ProtoMessage()    {}
+func (*GetRegexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *GetRegexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegexResponse.Unmarshal(m, b)
+}
+func (m *GetRegexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegexResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegexResponse.Merge(m, src)
+}
+func (m *GetRegexResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegexResponse.Size(m)
+}
+func (m *GetRegexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegexResponse proto.InternalMessageInfo
+
+func (m *GetRegexResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetRegexResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetPrefixRequest struct {
+	Prefix               string          `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPrefixRequest) Reset()         { *m = GetPrefixRequest{} }
+func (m *GetPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixRequest) ProtoMessage()    {}
+func (*GetPrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+
+func (m *GetPrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixRequest.Merge(m, src)
+}
+func (m *GetPrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixRequest.Size(m)
+}
+func (m *GetPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixRequest proto.InternalMessageInfo
+
+func (m *GetPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *GetPrefixRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetPrefixRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetPrefixRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GetPrefixResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPrefixResponse) Reset()         { *m = GetPrefixResponse{} }
+func (m *GetPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixResponse) ProtoMessage()    {}
+func (*GetPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+
+func (m *GetPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixResponse.Merge(m, src)
+}
+func (m *GetPrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixResponse.Size(m)
+}
+func (m *GetPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixResponse proto.InternalMessageInfo
+
+func (m *GetPrefixResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *GetPrefixResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+type ScanBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanBoundRequest) Reset()         { *m = ScanBoundRequest{} }
+func (m *ScanBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundRequest) ProtoMessage()    {}
+func (*ScanBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+}
+
+func (m *ScanBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundRequest.Merge(m, src)
+}
+func (m *ScanBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundRequest.Size(m)
+}
+func (m *ScanBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundRequest proto.InternalMessageInfo
+
+func (m *ScanBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanBoundRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanBoundRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanBoundResponse) Reset()         { *m = ScanBoundResponse{} }
+func (m *ScanBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanBoundResponse) ProtoMessage()    {}
+func (*ScanBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *ScanBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoundResponse.Merge(m, src)
+}
+func (m *ScanBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanBoundResponse.Size(m)
+}
+func (m *ScanBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoundResponse proto.InternalMessageInfo
+
+func (m *ScanBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanBoundResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanPrefixBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Prefix               string          `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPrefixBoundRequest) Reset()         { *m = ScanPrefixBoundRequest{} }
+func (m *ScanPrefixBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundRequest) ProtoMessage()    {}
+func (*ScanPrefixBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *ScanPrefixBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundRequest.Merge(m, src)
+}
+func (m *ScanPrefixBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundRequest.Size(m)
+}
+func (m *ScanPrefixBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundRequest proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanPrefixBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanPrefixBoundRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanPrefixBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPrefixBoundResponse) Reset()         { *m = ScanPrefixBoundResponse{} }
+func (m *ScanPrefixBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoundResponse) ProtoMessage()    {}
+func (*ScanPrefixBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *ScanPrefixBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoundResponse.Merge(m, src)
+}
+func (m *ScanPrefixBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoundResponse.Size(m)
+}
+func (m *ScanPrefixBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoundResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoundResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanRegexBoundRequest struct {
+	Bound                *Bound          `protobuf:"bytes,1,opt,name=bound,proto3" json:"bound,omitempty"`
+	Regex                string          `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanRegexBoundRequest) Reset()         { *m = ScanRegexBoundRequest{} }
+func (m *ScanRegexBoundRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundRequest) ProtoMessage()    {}
+func (*ScanRegexBoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *ScanRegexBoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundRequest.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundRequest.Merge(m, src)
+}
+func (m *ScanRegexBoundRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundRequest.Size(m)
+}
+func (m *ScanRegexBoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundRequest proto.InternalMessageInfo
+
+func (m *ScanRegexBoundRequest) GetBound() *Bound {
+	if m != nil {
+		return m.Bound
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanRegexBoundRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanRegexBoundRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanRegexBoundResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanRegexBoundResponse) Reset()         { *m = ScanRegexBoundResponse{} }
+func (m *ScanRegexBoundResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoundResponse) ProtoMessage()    {}
+func (*ScanRegexBoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *ScanRegexBoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoundResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexBoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoundResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoundResponse.Merge(m, src)
+}
+func (m *ScanRegexBoundResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoundResponse.Size(m)
+}
+func (m *ScanRegexBoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoundResponse proto.InternalMessageInfo
+
+func (m *ScanRegexBoundResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanRegexBoundResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanPolygonRequest struct {
+	Polygon              *Polygon        `protobuf:"bytes,1,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPolygonRequest) Reset()         { *m = ScanPolygonRequest{} }
+func (m *ScanPolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPolygonRequest) ProtoMessage()    {}
+func (*ScanPolygonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *ScanPolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPolygonRequest.Unmarshal(m, b)
+}
+func (m *ScanPolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPolygonRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPolygonRequest.Merge(m, src)
+}
+func (m *ScanPolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPolygonRequest.Size(m)
+}
+func (m *ScanPolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPolygonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPolygonRequest proto.InternalMessageInfo
+
+func (m *ScanPolygonRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPolygonRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanPolygonRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanPolygonResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPolygonResponse) Reset()         { *m = ScanPolygonResponse{} }
+func (m *ScanPolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPolygonResponse) ProtoMessage()    {}
+func (*ScanPolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *ScanPolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPolygonResponse.Unmarshal(m, b)
+}
+func (m *ScanPolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPolygonResponse.Merge(m, src)
+}
+func (m *ScanPolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPolygonResponse.Size(m)
+}
+func (m *ScanPolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPolygonResponse proto.InternalMessageInfo
+
+func (m *ScanPolygonResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPolygonResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanPrefixPolygonRequest struct {
+	Polygon              *Polygon        `protobuf:"bytes,1,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Prefix               string          `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPrefixPolygonRequest) Reset()         { *m = ScanPrefixPolygonRequest{} }
+func (m *ScanPrefixPolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixPolygonRequest) ProtoMessage()    {}
+func (*ScanPrefixPolygonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *ScanPrefixPolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixPolygonRequest.Unmarshal(m, b)
+}
+func (m *ScanPrefixPolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixPolygonRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixPolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixPolygonRequest.Merge(m, src)
+}
+func (m *ScanPrefixPolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixPolygonRequest.Size(m)
+}
+func (m *ScanPrefixPolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixPolygonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixPolygonRequest proto.InternalMessageInfo
+
+func (m *ScanPrefixPolygonRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ScanPrefixPolygonRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanPrefixPolygonRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPrefixPolygonRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanPrefixPolygonRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanPrefixPolygonResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPrefixPolygonResponse) Reset()         { *m = ScanPrefixPolygonResponse{} }
+func (m *ScanPrefixPolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixPolygonResponse) ProtoMessage()    {}
+func (*ScanPrefixPolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *ScanPrefixPolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixPolygonResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixPolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixPolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixPolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixPolygonResponse.Merge(m, src)
+}
+func (m *ScanPrefixPolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixPolygonResponse.Size(m)
+}
+func (m *ScanPrefixPolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixPolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixPolygonResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixPolygonResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPrefixPolygonResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanRegexPolygonRequest struct {
+	Polygon              *Polygon        `protobuf:"bytes,1,opt,name=polygon,proto3" json:"polygon,omitempty"`
+	Regex                string          `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanRegexPolygonRequest) Reset()         { *m = ScanRegexPolygonRequest{} }
+func (m *ScanRegexPolygonRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexPolygonRequest) ProtoMessage()    {}
+func (*ScanRegexPolygonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *ScanRegexPolygonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexPolygonRequest.Unmarshal(m, b)
+}
+func (m *ScanRegexPolygonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexPolygonRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexPolygonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexPolygonRequest.Merge(m, src)
+}
+func (m *ScanRegexPolygonRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexPolygonRequest.Size(m)
+}
+func (m *ScanRegexPolygonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexPolygonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexPolygonRequest proto.InternalMessageInfo
+
+func (m *ScanRegexPolygonRequest) GetPolygon() *Polygon {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *ScanRegexPolygonRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanRegexPolygonRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanRegexPolygonRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanRegexPolygonRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanRegexPolygonResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanRegexPolygonResponse) Reset()         { *m = ScanRegexPolygonResponse{} }
+func (m *ScanRegexPolygonResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexPolygonResponse) ProtoMessage()    {}
+func (*ScanRegexPolygonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *ScanRegexPolygonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexPolygonResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexPolygonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexPolygonResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexPolygonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexPolygonResponse.Merge(m, src)
+}
+func (m *ScanRegexPolygonResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexPolygonResponse.Size(m)
+}
+func (m *ScanRegexPolygonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexPolygonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexPolygonResponse proto.InternalMessageInfo
+
+func (m *ScanRegexPolygonResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanRegexPolygonResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanBoxRequest struct {
+	Box                  *Box            `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Keys                 []string        `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanBoxRequest) Reset()         { *m = ScanBoxRequest{} }
+func (m *ScanBoxRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanBoxRequest) ProtoMessage()    {}
+func (*ScanBoxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *ScanBoxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoxRequest.Unmarshal(m, b)
+}
+func (m *ScanBoxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoxRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanBoxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoxRequest.Merge(m, src)
+}
+func (m *ScanBoxRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanBoxRequest.Size(m)
+}
+func (m *ScanBoxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoxRequest proto.InternalMessageInfo
+
+func (m *ScanBoxRequest) GetBox() *Box {
+	if m != nil {
+		return m.Box
+	}
+	return nil
+}
+
+func (m *ScanBoxRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ScanBoxRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanBoxRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanBoxRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanBoxResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanBoxResponse) Reset()         { *m = ScanBoxResponse{} }
+func (m *ScanBoxResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanBoxResponse) ProtoMessage()    {}
+func (*ScanBoxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *ScanBoxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanBoxResponse.Unmarshal(m, b)
+}
+func (m *ScanBoxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanBoxResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanBoxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanBoxResponse.Merge(m, src)
+}
+func (m *ScanBoxResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanBoxResponse.Size(m)
+}
+func (m *ScanBoxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanBoxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanBoxResponse proto.InternalMessageInfo
+
+func (m *ScanBoxResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanBoxResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanPrefixBoxRequest struct {
+	Box                  *Box            `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Prefix               string          `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanPrefixBoxRequest) Reset()         { *m = ScanPrefixBoxRequest{} }
+func (m *ScanPrefixBoxRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoxRequest) ProtoMessage()    {}
+func (*ScanPrefixBoxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *ScanPrefixBoxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoxRequest.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoxRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoxRequest.Merge(m, src)
+}
+func (m *ScanPrefixBoxRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoxRequest.Size(m)
+}
+func (m *ScanPrefixBoxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoxRequest proto.InternalMessageInfo
+
+func (m *ScanPrefixBoxRequest) GetBox() *Box {
+	if m != nil {
+		return m.Box
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoxRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ScanPrefixBoxRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoxRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanPrefixBoxRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanPrefixBoxResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanPrefixBoxResponse) Reset()         { *m = ScanPrefixBoxResponse{} }
+func (m *ScanPrefixBoxResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanPrefixBoxResponse) ProtoMessage()    {}
+func (*ScanPrefixBoxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *ScanPrefixBoxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanPrefixBoxResponse.Unmarshal(m, b)
+}
+func (m *ScanPrefixBoxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanPrefixBoxResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanPrefixBoxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanPrefixBoxResponse.Merge(m, src)
+}
+func (m *ScanPrefixBoxResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanPrefixBoxResponse.Size(m)
+}
+func (m *ScanPrefixBoxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanPrefixBoxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanPrefixBoxResponse proto.InternalMessageInfo
+
+func (m *ScanPrefixBoxResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanPrefixBoxResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type ScanRegexBoxRequest struct {
+	Box                  *Box            `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Regex                string          `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	Filter               *MetadataFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScanRegexBoxRequest) Reset()         { *m = ScanRegexBoxRequest{} }
+func (m *ScanRegexBoxRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoxRequest) ProtoMessage()    {}
+func (*ScanRegexBoxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *ScanRegexBoxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoxRequest.Unmarshal(m, b)
+}
+func (m *ScanRegexBoxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoxRequest.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoxRequest.Merge(m, src)
+}
+func (m *ScanRegexBoxRequest) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoxRequest.Size(m)
+}
+func (m *ScanRegexBoxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoxRequest proto.InternalMessageInfo
+
+func (m *ScanRegexBoxRequest) GetBox() *Box {
+	if m != nil {
+		return m.Box
+	}
+	return nil
+}
+
+func (m *ScanRegexBoxRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *ScanRegexBoxRequest) GetFilter() *MetadataFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ScanRegexBoxRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ScanRegexBoxRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ScanRegexBoxResponse struct {
+	Objects              map[string]*ObjectDetail `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScanRegexBoxResponse) Reset()         { *m = ScanRegexBoxResponse{} }
+func (m *ScanRegexBoxResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanRegexBoxResponse) ProtoMessage()    {}
+func (*ScanRegexBoxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *ScanRegexBoxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScanRegexBoxResponse.Unmarshal(m, b)
+}
+func (m *ScanRegexBoxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScanRegexBoxResponse.Marshal(b, m, deterministic)
+}
+func (m *ScanRegexBoxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanRegexBoxResponse.Merge(m, src)
+}
+func (m *ScanRegexBoxResponse) XXX_Size() int {
+	return xxx_messageInfo_ScanRegexBoxResponse.Size(m)
+}
+func (m *ScanRegexBoxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanRegexBoxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScanRegexBoxResponse proto.InternalMessageInfo
+
+func (m *ScanRegexBoxResponse) GetObjects() map[string]*ObjectDetail {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+func (m *ScanRegexBoxResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type NearestRequest struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Prefix               string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Regex                string   `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NearestRequest) Reset()         { *m = NearestRequest{} }
+func (m *NearestRequest) String() string { return proto.CompactTextString(m) }
+func (*NearestRequest) ProtoMessage()    {}
+func (*NearestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *NearestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestRequest.Unmarshal(m, b)
+}
+func (m *NearestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestRequest.Marshal(b, m, deterministic)
+}
+func (m *NearestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestRequest.Merge(m, src)
+}
+func (m *NearestRequest) XXX_Size() int {
+	return xxx_messageInfo_NearestRequest.Size(m)
+}
+func (m *NearestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestRequest proto.InternalMessageInfo
+
+func (m *NearestRequest) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *NearestRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *NearestRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *NearestRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *NearestRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+//NearestResult is an object detail and its haversine distance in meters from the requested point
+type NearestResult struct {
+	Object               *ObjectDetail `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Distance             float64       `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NearestResult) Reset()         { *m = NearestResult{} }
+func (m *NearestResult) String() string { return proto.CompactTextString(m) }
+func (*NearestResult) ProtoMessage()    {}
+func (*NearestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *NearestResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestResult.Unmarshal(m, b)
+}
+func (m *NearestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestResult.Marshal(b, m, deterministic)
+}
+func (m *NearestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestResult.Merge(m, src)
+}
+func (m *NearestResult) XXX_Size() int {
+	return xxx_messageInfo_NearestResult.Size(m)
+}
+func (m *NearestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestResult proto.InternalMessageInfo
+
+func (m *NearestResult) GetObject() *ObjectDetail {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *NearestResult) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type NearestResponse struct {
+	Results              []*NearestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NearestResponse) Reset()         { *m = NearestResponse{} }
+func (m *NearestResponse) String() string { return proto.CompactTextString(m) }
+func (*NearestResponse) ProtoMessage()    {}
+func (*NearestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *NearestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NearestResponse.Unmarshal(m, b)
+}
+func (m *NearestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NearestResponse.Marshal(b, m, deterministic)
+}
+func (m *NearestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NearestResponse.Merge(m, src)
+}
+func (m *NearestResponse) XXX_Size() int {
+	return xxx_messageInfo_NearestResponse.Size(m)
+}
+func (m *NearestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NearestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NearestResponse proto.InternalMessageInfo
+
+func (m *NearestResponse) GetResults() []*NearestResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type AddMetadataIndexRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddMetadataIndexRequest) Reset()         { *m = AddMetadataIndexRequest{} }
+func (m *AddMetadataIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMetadataIndexRequest) ProtoMessage()    {}
+func (*AddMetadataIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *AddMetadataIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMetadataIndexRequest.Unmarshal(m, b)
+}
+func (m *AddMetadataIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMetadataIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *AddMetadataIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMetadataIndexRequest.Merge(m, src)
+}
+func (m *AddMetadataIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_AddMetadataIndexRequest.Size(m)
+}
+func (m *AddMetadataIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMetadataIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMetadataIndexRequest proto.InternalMessageInfo
+
+func (m *AddMetadataIndexRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type AddMetadataIndexResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddMetadataIndexResponse) Reset()         { *m = AddMetadataIndexResponse{} }
+func (m *AddMetadataIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*AddMetadataIndexResponse) ProtoMessage()    {}
+func (*AddMetadataIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *AddMetadataIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMetadataIndexResponse.Unmarshal(m, b)
+}
+func (m *AddMetadataIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMetadataIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *AddMetadataIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMetadataIndexResponse.Merge(m, src)
+}
+func (m *AddMetadataIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_AddMetadataIndexResponse.Size(m)
+}
+func (m *AddMetadataIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMetadataIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMetadataIndexResponse proto.InternalMessageInfo
+
+type RemoveMetadataIndexRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMetadataIndexRequest) Reset()         { *m = RemoveMetadataIndexRequest{} }
+func (m *RemoveMetadataIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveMetadataIndexRequest) ProtoMessage()    {}
+func (*RemoveMetadataIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *RemoveMetadataIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMetadataIndexRequest.Unmarshal(m, b)
+}
+func (m *RemoveMetadataIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMetadataIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveMetadataIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMetadataIndexRequest.Merge(m, src)
+}
+func (m *RemoveMetadataIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveMetadataIndexRequest.Size(m)
+}
+func (m *RemoveMetadataIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMetadataIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMetadataIndexRequest proto.InternalMessageInfo
+
+func (m *RemoveMetadataIndexRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type RemoveMetadataIndexResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMetadataIndexResponse) Reset()         { *m = RemoveMetadataIndexResponse{} }
+func (m *RemoveMetadataIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveMetadataIndexResponse) ProtoMessage()    {}
+func (*RemoveMetadataIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *RemoveMetadataIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMetadataIndexResponse.Unmarshal(m, b)
+}
+func (m *RemoveMetadataIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMetadataIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveMetadataIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMetadataIndexResponse.Merge(m, src)
+}
+func (m *RemoveMetadataIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveMetadataIndexResponse.Size(m)
+}
+func (m *RemoveMetadataIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMetadataIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMetadataIndexResponse proto.InternalMessageInfo
+
+type GetMetadataIndexesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadataIndexesRequest) Reset()         { *m = GetMetadataIndexesRequest{} }
+func (m *GetMetadataIndexesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataIndexesRequest) ProtoMessage()    {}
+func (*GetMetadataIndexesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *GetMetadataIndexesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadataIndexesRequest.Unmarshal(m, b)
+}
+func (m *GetMetadataIndexesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadataIndexesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMetadataIndexesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataIndexesRequest.Merge(m, src)
+}
+func (m *GetMetadataIndexesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMetadataIndexesRequest.Size(m)
+}
+func (m *GetMetadataIndexesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataIndexesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadataIndexesRequest proto.InternalMessageInfo
+
+type GetMetadataIndexesResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadataIndexesResponse) Reset()         { *m = GetMetadataIndexesResponse{} }
+func (m *GetMetadataIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataIndexesResponse) ProtoMessage()    {}
+func (*GetMetadataIndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *GetMetadataIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadataIndexesResponse.Unmarshal(m, b)
+}
+func (m *GetMetadataIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadataIndexesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMetadataIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataIndexesResponse.Merge(m, src)
+}
+func (m *GetMetadataIndexesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetadataIndexesResponse.Size(m)
+}
+func (m *GetMetadataIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataIndexesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadataIndexesResponse proto.InternalMessageInfo
+
+func (m *GetMetadataIndexesResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	FromUnix             int64    `protobuf:"varint,2,opt,name=from_unix,json=fromUnix,proto3" json:"from_unix,omitempty"`
+	ToUnix               int64    `protobuf:"varint,3,opt,name=to_unix,json=toUnix,proto3" json:"to_unix,omitempty"`
+	Interval             int64    `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHistoryRequest) Reset()         { *m = GetHistoryRequest{} }
+func (m *GetHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHistoryRequest) ProtoMessage()    {}
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *GetHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoryRequest.Merge(m, src)
+}
+func (m *GetHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHistoryRequest.Size(m)
+}
+func (m *GetHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHistoryRequest proto.InternalMessageInfo
+
+func (m *GetHistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetHistoryRequest) GetFromUnix() int64 {
+	if m != nil {
+		return m.FromUnix
+	}
+	return 0
+}
+
+func (m *GetHistoryRequest) GetToUnix() int64 {
+	if m != nil {
+		return m.ToUnix
+	}
+	return 0
+}
+
+func (m *GetHistoryRequest) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetHistoryResponse) Reset()         { *m = GetHistoryResponse{} }
+func (m *GetHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHistoryResponse) ProtoMessage()    {}
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *GetHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoryResponse.Merge(m, src)
+}
+func (m *GetHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHistoryResponse.Size(m)
+}
+func (m *GetHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHistoryResponse proto.InternalMessageInfo
+
+func (m *GetHistoryResponse) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type SetGeofenceRequest struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetGeofenceRequest) Reset()         { *m = SetGeofenceRequest{} }
+func (m *SetGeofenceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetGeofenceRequest) ProtoMessage()    {}
+func (*SetGeofenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *SetGeofenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGeofenceRequest.Unmarshal(m, b)
+}
+func (m *SetGeofenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGeofenceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetGeofenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGeofenceRequest.Merge(m, src)
+}
+func (m *SetGeofenceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetGeofenceRequest.Size(m)
+}
+func (m *SetGeofenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGeofenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGeofenceRequest proto.InternalMessageInfo
+
+func (m *SetGeofenceRequest) GetGeofence() *Geofence {
+	if m != nil {
+		return m.Geofence
+	}
+	return nil
+}
+
+type SetGeofenceResponse struct {
+	Geofence             *Geofence `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetGeofenceResponse) Reset()         { *m = SetGeofenceResponse{} }
+func (m *SetGeofenceResponse) String() string { return proto.CompactTextString(m) }
+func (*SetGeofenceResponse) ProtoMessage()    {}
+func (*SetGeofenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *SetGeofenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGeofenceResponse.Unmarshal(m, b)
+}
+func (m *SetGeofenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGeofenceResponse.Marshal(b, m, deterministic)
+}
+func (m *SetGeofenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGeofenceResponse.Merge(m, src)
+}
+func (m *SetGeofenceResponse) XXX_Size() int {
+	return xxx_messageInfo_SetGeofenceResponse.Size(m)
+}
+func (m *SetGeofenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGeofenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGeofenceResponse proto.InternalMessageInfo
+
+func (m *SetGeofenceResponse) GetGeofence() *Geofence {
+	if m != nil {
+		return m.Geofence
+	}
+	return nil
+}
+
+type GetGeofencesRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGeofencesRequest) Reset()         { *m = GetGeofencesRequest{} }
+func (m *GetGeofencesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGeofencesRequest) ProtoMessage()    {}
+func (*GetGeofencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *GetGeofencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGeofencesRequest.Unmarshal(m, b)
+}
+func (m *GetGeofencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGeofencesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGeofencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGeofencesRequest.Merge(m, src)
+}
+func (m *GetGeofencesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGeofencesRequest.Size(m)
+}
+func (m *GetGeofencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGeofencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGeofencesRequest proto.InternalMessageInfo
+
+func (m *GetGeofencesRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type GetGeofencesResponse struct {
+	Geofences            map[string]*Geofence `protobuf:"bytes,1,rep,name=geofences,proto3" json:"geofences,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetGeofencesResponse) Reset()         { *m = GetGeofencesResponse{} }
+func (m *GetGeofencesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGeofencesResponse) ProtoMessage()    {}
+func (*GetGeofencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *GetGeofencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGeofencesResponse.Unmarshal(m, b)
+}
+func (m *GetGeofencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGeofencesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGeofencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGeofencesResponse.Merge(m, src)
+}
+func (m *GetGeofencesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGeofencesResponse.Size(m)
+}
+func (m *GetGeofencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGeofencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGeofencesResponse proto.InternalMessageInfo
+
+func (m *GetGeofencesResponse) GetGeofences() map[string]*Geofence {
+	if m != nil {
+		return m.Geofences
+	}
+	return nil
+}
+
+type DeleteGeofencesRequest struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGeofencesRequest) Reset()         { *m = DeleteGeofencesRequest{} }
+func (m *DeleteGeofencesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofencesRequest) ProtoMessage()    {}
+func (*DeleteGeofencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *DeleteGeofencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofencesRequest.Unmarshal(m, b)
+}
+func (m *DeleteGeofencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofencesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteGeofencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofencesRequest.Merge(m, src)
+}
+func (m *DeleteGeofencesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofencesRequest.Size(m)
+}
+func (m *DeleteGeofencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGeofencesRequest proto.InternalMessageInfo
+
+func (m *DeleteGeofencesRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type DeleteGeofencesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGeofencesResponse) Reset()         { *m = DeleteGeofencesResponse{} }
+func (m *DeleteGeofencesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGeofencesResponse) ProtoMessage()    {}
+func (*DeleteGeofencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *DeleteGeofencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGeofencesResponse.Unmarshal(m, b)
+}
+func (m *DeleteGeofencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGeofencesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteGeofencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGeofencesResponse.Merge(m, src)
+}
+func (m *DeleteGeofencesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteGeofencesResponse.Size(m)
+}
+func (m *DeleteGeofencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGeofencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGeofencesResponse proto.InternalMessageInfo
+
+type StreamGeofenceEventsRequest struct {
+	ClientId             string               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	GeofenceKeys         []string             `protobuf:"bytes,2,rep,name=geofence_keys,json=geofenceKeys,proto3" json:"geofence_keys,omitempty"`
+	Transitions          []GeofenceTransition `protobuf:"varint,3,rep,packed,name=transitions,proto3,enum=api.GeofenceTransition" json:"transitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StreamGeofenceEventsRequest) Reset()         { *m = StreamGeofenceEventsRequest{} }
+func (m *StreamGeofenceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamGeofenceEventsRequest) ProtoMessage()    {}
+func (*StreamGeofenceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *StreamGeofenceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamGeofenceEventsRequest.Unmarshal(m, b)
+}
+func (m *StreamGeofenceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamGeofenceEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamGeofenceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGeofenceEventsRequest.Merge(m, src)
+}
+func (m *StreamGeofenceEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamGeofenceEventsRequest.Size(m)
+}
+func (m *StreamGeofenceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGeofenceEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamGeofenceEventsRequest proto.InternalMessageInfo
+
+func (m *StreamGeofenceEventsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StreamGeofenceEventsRequest) GetGeofenceKeys() []string {
+	if m != nil {
+		return m.GeofenceKeys
+	}
+	return nil
+}
+
+func (m *StreamGeofenceEventsRequest) GetTransitions() []GeofenceTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+type StreamGeofenceEventsResponse struct {
+	Event                *GeofenceEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StreamGeofenceEventsResponse) Reset()         { *m = StreamGeofenceEventsResponse{} }
+func (m *StreamGeofenceEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamGeofenceEventsResponse) ProtoMessage()    {}
+func (*StreamGeofenceEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *StreamGeofenceEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamGeofenceEventsResponse.Unmarshal(m, b)
+}
+func (m *StreamGeofenceEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamGeofenceEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamGeofenceEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGeofenceEventsResponse.Merge(m, src)
+}
+func (m *StreamGeofenceEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamGeofenceEventsResponse.Size(m)
+}
+func (m *StreamGeofenceEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGeofenceEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamGeofenceEventsResponse proto.InternalMessageInfo
+
+func (m *StreamGeofenceEventsResponse) GetEvent() *GeofenceEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type SetWebhookRequest struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetWebhookRequest) Reset()         { *m = SetWebhookRequest{} }
+func (m *SetWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*SetWebhookRequest) ProtoMessage()    {}
+func (*SetWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *SetWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetWebhookRequest.Unmarshal(m, b)
+}
+func (m *SetWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *SetWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWebhookRequest.Merge(m, src)
+}
+func (m *SetWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_SetWebhookRequest.Size(m)
+}
+func (m *SetWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWebhookRequest proto.InternalMessageInfo
+
+func (m *SetWebhookRequest) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type SetWebhookResponse struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetWebhookResponse) Reset()         { *m = SetWebhookResponse{} }
+func (m *SetWebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*SetWebhookResponse) ProtoMessage()    {}
+func (*SetWebhookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *SetWebhookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetWebhookResponse.Unmarshal(m, b)
+}
+func (m *SetWebhookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetWebhookResponse.Marshal(b, m, deterministic)
+}
+func (m *SetWebhookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWebhookResponse.Merge(m, src)
+}
+func (m *SetWebhookResponse) XXX_Size() int {
+	return xxx_messageInfo_SetWebhookResponse.Size(m)
+}
+func (m *SetWebhookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWebhookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWebhookResponse proto.InternalMessageInfo
+
+func (m *SetWebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type GetWebhooksRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWebhooksRequest) Reset()         { *m = GetWebhooksRequest{} }
+func (m *GetWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWebhooksRequest) ProtoMessage()    {}
+func (*GetWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *GetWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebhooksRequest.Unmarshal(m, b)
+}
+func (m *GetWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebhooksRequest.Merge(m, src)
+}
+func (m *GetWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWebhooksRequest.Size(m)
+}
+func (m *GetWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebhooksRequest proto.InternalMessageInfo
+
+func (m *GetWebhooksRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type GetWebhooksResponse struct {
+	Webhooks             map[string]*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetWebhooksResponse) Reset()         { *m = GetWebhooksResponse{} }
+func (m *GetWebhooksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWebhooksResponse) ProtoMessage()    {}
+func (*GetWebhooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *GetWebhooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebhooksResponse.Unmarshal(m, b)
+}
+func (m *GetWebhooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebhooksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetWebhooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebhooksResponse.Merge(m, src)
+}
+func (m *GetWebhooksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWebhooksResponse.Size(m)
+}
+func (m *GetWebhooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebhooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebhooksResponse proto.InternalMessageInfo
+
+func (m *GetWebhooksResponse) GetWebhooks() map[string]*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhooksRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhooksRequest) Reset()         { *m = DeleteWebhooksRequest{} }
+func (m *DeleteWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhooksRequest) ProtoMessage()    {}
+func (*DeleteWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *DeleteWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhooksRequest.Unmarshal(m, b)
+}
+func (m *DeleteWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhooksRequest.Merge(m, src)
+}
+func (m *DeleteWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhooksRequest.Size(m)
+}
+func (m *DeleteWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhooksRequest proto.InternalMessageInfo
+
+func (m *DeleteWebhooksRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type DeleteWebhooksResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteWebhooksResponse) Reset()         { *m = DeleteWebhooksResponse{} }
+func (m *DeleteWebhooksResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhooksResponse) ProtoMessage()    {}
+func (*DeleteWebhooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *DeleteWebhooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteWebhooksResponse.Unmarshal(m, b)
+}
+func (m *DeleteWebhooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteWebhooksResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteWebhooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWebhooksResponse.Merge(m, src)
+}
+func (m *DeleteWebhooksResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteWebhooksResponse.Size(m)
+}
+func (m *DeleteWebhooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWebhooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWebhooksResponse proto.InternalMessageInfo
+
+type GetDeadLettersRequest struct {
+	WebhookId            string   `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeadLettersRequest) Reset()         { *m = GetDeadLettersRequest{} }
+func (m *GetDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeadLettersRequest) ProtoMessage()    {}
+func (*GetDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *GetDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *GetDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeadLettersRequest.Merge(m, src)
+}
+func (m *GetDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeadLettersRequest.Size(m)
+}
+func (m *GetDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeadLettersRequest proto.InternalMessageInfo
+
+func (m *GetDeadLettersRequest) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+type GetDeadLettersResponse struct {
+	Deliveries           []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetDeadLettersResponse) Reset()         { *m = GetDeadLettersResponse{} }
+func (m *GetDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeadLettersResponse) ProtoMessage()    {}
+func (*GetDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *GetDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *GetDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeadLettersResponse.Merge(m, src)
+}
+func (m *GetDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeadLettersResponse.Size(m)
+}
+func (m *GetDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeadLettersResponse proto.InternalMessageInfo
+
+func (m *GetDeadLettersResponse) GetDeliveries() []*WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type RedeliverDeadLettersRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeliverDeadLettersRequest) Reset()         { *m = RedeliverDeadLettersRequest{} }
+func (m *RedeliverDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeliverDeadLettersRequest) ProtoMessage()    {}
+func (*RedeliverDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *RedeliverDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeliverDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *RedeliverDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeliverDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (m *RedeliverDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeliverDeadLettersRequest.Merge(m, src)
+}
+func (m *RedeliverDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_RedeliverDeadLettersRequest.Size(m)
+}
+func (m *RedeliverDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeliverDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeliverDeadLettersRequest proto.InternalMessageInfo
+
+func (m *RedeliverDeadLettersRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type RedeliverDeadLettersResponse struct {
+	Delivered            []string `protobuf:"bytes,1,rep,name=delivered,proto3" json:"delivered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeliverDeadLettersResponse) Reset()         { *m = RedeliverDeadLettersResponse{} }
+func (m *RedeliverDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*RedeliverDeadLettersResponse) ProtoMessage()    {}
+func (*RedeliverDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *RedeliverDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeliverDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *RedeliverDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeliverDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (m *RedeliverDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeliverDeadLettersResponse.Merge(m, src)
+}
+func (m *RedeliverDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_RedeliverDeadLettersResponse.Size(m)
+}
+func (m *RedeliverDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeliverDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeliverDeadLettersResponse proto.InternalMessageInfo
+
+func (m *RedeliverDeadLettersResponse) GetDelivered() []string {
+	if m != nil {
+		return m.Delivered
+	}
+	return nil
+}
+
+type GetPointRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointRequest) Reset()         { *m = GetPointRequest{} }
+func (m *GetPointRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPointRequest) ProtoMessage()    {}
+func (*GetPointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *GetPointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointRequest.Unmarshal(m, b)
+}
+func (m *GetPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointRequest.Merge(m, src)
+}
+func (m *GetPointRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPointRequest.Size(m)
+}
+func (m *GetPointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointRequest proto.InternalMessageInfo
+
+func (m *GetPointRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetPointResponse struct {
+	Point                *Point   `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPointResponse) Reset()         { *m = GetPointResponse{} }
+func (m *GetPointResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPointResponse) ProtoMessage()    {}
+func (*GetPointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *GetPointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPointResponse.Unmarshal(m, b)
+}
+func (m *GetPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPointResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPointResponse.Merge(m, src)
+}
+func (m *GetPointResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPointResponse.Size(m)
+}
+func (m *GetPointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPointResponse proto.InternalMessageInfo
+
+func (m *GetPointResponse) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+//MapsCacheStats describes a maps cache
+type MapsCacheStats struct {
+	Type                 MapsCacheType `protobuf:"varint,1,opt,name=type,proto3,enum=api.MapsCacheType" json:"type,omitempty"`
+	Entries              int64         `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	SizeBytes            int64         `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	MaxEntries           int64         `protobuf:"varint,4,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	Hits                 uint64        `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               uint64        `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MapsCacheStats) Reset()         { *m = MapsCacheStats{} }
+func (m *MapsCacheStats) String() string { return proto.CompactTextString(m) }
+func (*MapsCacheStats) ProtoMessage()    {}
+func (*MapsCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *MapsCacheStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MapsCacheStats.Unmarshal(m, b)
+}
+func (m *MapsCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MapsCacheStats.Marshal(b, m, deterministic)
+}
+func (m *MapsCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapsCacheStats.Merge(m, src)
+}
+func (m *MapsCacheStats) XXX_Size() int {
+	return xxx_messageInfo_MapsCacheStats.Size(m)
+}
+func (m *MapsCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapsCacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MapsCacheStats proto.InternalMessageInfo
+
+func (m *MapsCacheStats) GetType() MapsCacheType {
+	if m != nil {
+		return m.Type
+	}
+	return MapsCacheType_DirectionsCache
+}
+
+func (m *MapsCacheStats) GetEntries() int64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *MapsCacheStats) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *MapsCacheStats) GetMaxEntries() int64 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+func (m *MapsCacheStats) GetHits() uint64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *MapsCacheStats) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+type GetMapsCacheStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMapsCacheStatsRequest) Reset()         { *m = GetMapsCacheStatsRequest{} }
+func (m *GetMapsCacheStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMapsCacheStatsRequest) ProtoMessage()    {}
+func (*GetMapsCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *GetMapsCacheStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMapsCacheStatsRequest.Unmarshal(m, b)
+}
+func (m *GetMapsCacheStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMapsCacheStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMapsCacheStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMapsCacheStatsRequest.Merge(m, src)
+}
+func (m *GetMapsCacheStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMapsCacheStatsRequest.Size(m)
+}
+func (m *GetMapsCacheStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMapsCacheStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMapsCacheStatsRequest proto.InternalMessageInfo
+
+type GetMapsCacheStatsResponse struct {
+	Caches               []*MapsCacheStats `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMapsCacheStatsResponse) Reset()         { *m = GetMapsCacheStatsResponse{} }
+func (m *GetMapsCacheStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMapsCacheStatsResponse) ProtoMessage()    {}
+func (*GetMapsCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *GetMapsCacheStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMapsCacheStatsResponse.Unmarshal(m, b)
+}
+func (m *GetMapsCacheStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMapsCacheStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMapsCacheStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMapsCacheStatsResponse.Merge(m, src)
+}
+func (m *GetMapsCacheStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMapsCacheStatsResponse.Size(m)
+}
+func (m *GetMapsCacheStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMapsCacheStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMapsCacheStatsResponse proto.InternalMessageInfo
+
+func (m *GetMapsCacheStatsResponse) GetCaches() []*MapsCacheStats {
+	if m != nil {
+		return m.Caches
+	}
+	return nil
+}
+
+type PurgeMapsCacheRequest struct {
+	Types                []MapsCacheType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=api.MapsCacheType" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PurgeMapsCacheRequest) Reset()         { *m = PurgeMapsCacheRequest{} }
+func (m *PurgeMapsCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeMapsCacheRequest) ProtoMessage()    {}
+func (*PurgeMapsCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *PurgeMapsCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMapsCacheRequest.Unmarshal(m, b)
+}
+func (m *PurgeMapsCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMapsCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeMapsCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMapsCacheRequest.Merge(m, src)
+}
+func (m *PurgeMapsCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeMapsCacheRequest.Size(m)
+}
+func (m *PurgeMapsCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMapsCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMapsCacheRequest proto.InternalMessageInfo
+
+func (m *PurgeMapsCacheRequest) GetTypes() []MapsCacheType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type PurgeMapsCacheResponse struct {
+	Purged               int64    `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeMapsCacheResponse) Reset()         { *m = PurgeMapsCacheResponse{} }
+func (m *PurgeMapsCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeMapsCacheResponse) ProtoMessage()    {}
+func (*PurgeMapsCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *PurgeMapsCacheResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMapsCacheResponse.Unmarshal(m, b)
+}
+func (m *PurgeMapsCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMapsCacheResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgeMapsCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMapsCacheResponse.Merge(m, src)
+}
+func (m *PurgeMapsCacheResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeMapsCacheResponse.Size(m)
+}
+func (m *PurgeMapsCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMapsCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMapsCacheResponse proto.InternalMessageInfo
+
+func (m *PurgeMapsCacheResponse) GetPurged() int64 {
+	if m != nil {
+		return m.Purged
+	}
+	return 0
+}
+
+type BoundMapsCacheRequest struct {
+	Type                 MapsCacheType `protobuf:"varint,1,opt,name=type,proto3,enum=api.MapsCacheType" json:"type,omitempty"`
+	MaxEntries           int64         `protobuf:"varint,2,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BoundMapsCacheRequest) Reset()         { *m = BoundMapsCacheRequest{} }
+func (m *BoundMapsCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*BoundMapsCacheRequest) ProtoMessage()    {}
+func (*BoundMapsCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *BoundMapsCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundMapsCacheRequest.Unmarshal(m, b)
+}
+func (m *BoundMapsCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundMapsCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *BoundMapsCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundMapsCacheRequest.Merge(m, src)
+}
+func (m *BoundMapsCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_BoundMapsCacheRequest.Size(m)
+}
+func (m *BoundMapsCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundMapsCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundMapsCacheRequest proto.InternalMessageInfo
+
+func (m *BoundMapsCacheRequest) GetType() MapsCacheType {
+	if m != nil {
+		return m.Type
+	}
+	return MapsCacheType_DirectionsCache
+}
+
+func (m *BoundMapsCacheRequest) GetMaxEntries() int64 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+type BoundMapsCacheResponse struct {
+	Evicted              int64    `protobuf:"varint,1,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BoundMapsCacheResponse) Reset()         { *m = BoundMapsCacheResponse{} }
+func (m *BoundMapsCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*BoundMapsCacheResponse) ProtoMessage()    {}
+func (*BoundMapsCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *BoundMapsCacheResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundMapsCacheResponse.Unmarshal(m, b)
+}
+func (m *BoundMapsCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundMapsCacheResponse.Marshal(b, m, deterministic)
+}
+func (m *BoundMapsCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundMapsCacheResponse.Merge(m, src)
+}
+func (m *BoundMapsCacheResponse) XXX_Size() int {
+	return xxx_messageInfo_BoundMapsCacheResponse.Size(m)
+}
+func (m *BoundMapsCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundMapsCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundMapsCacheResponse proto.InternalMessageInfo
+
+func (m *BoundMapsCacheResponse) GetEvicted() int64 {
+	if m != nil {
+		return m.Evicted
+	}
+	return 0
+}
+
+type PingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingRequest) Reset()         { *m = PingRequest{} }
+func (m *PingRequest) String() string { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()    {}
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+
+func (m *PingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
+}
+func (m *PingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingRequest.Marshal(b, m, deterministic)
+}
+func (m *PingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingRequest.Merge(m, src)
+}
+func (m *PingRequest) XXX_Size() int {
+	return xxx_messageInfo_PingRequest.Size(m)
+}
+func (m *PingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingRequest proto.InternalMessageInfo
+
+type PingResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingResponse) Reset()         { *m = PingResponse{} }
+func (m *PingResponse) String() string { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()    {}
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+
+func (m *PingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
+}
+func (m *PingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingResponse.Marshal(b, m, deterministic)
+}
+func (m *PingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingResponse.Merge(m, src)
+}
+func (m *PingResponse) XXX_Size() int {
+	return xxx_messageInfo_PingResponse.Size(m)
+}
+func (m *PingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingResponse proto.InternalMessageInfo
+
+func (m *PingResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("api.ObjectEventType", ObjectEventType_name, ObjectEventType_value)
+	proto.RegisterEnum("api.GeofenceTransition", GeofenceTransition_name, GeofenceTransition_value)
+	proto.RegisterEnum("api.WebhookEventType", WebhookEventType_name, WebhookEventType_value)
+	proto.RegisterEnum("api.MetadataOperator", MetadataOperator_name, MetadataOperator_value)
+	proto.RegisterEnum("api.TravelMode", TravelMode_name, TravelMode_value)
+	proto.RegisterEnum("api.MapsCacheType", MapsCacheType_name, MapsCacheType_value)
+	proto.RegisterType((*Point)(nil), "api.Point")
+	proto.RegisterType((*Bound)(nil), "api.Bound")
+	proto.RegisterType((*Ring)(nil), "api.Ring")
+	proto.RegisterType((*Polygon)(nil), "api.Polygon")
+	proto.RegisterType((*Box)(nil), "api.Box")
+	proto.RegisterType((*Object)(nil), "api.Object")
+	proto.RegisterMapType((map[string]string)(nil), "api.Object.MetadataEntry")
+	proto.RegisterType((*ObjectTracking)(nil), "api.ObjectTracking")
+	proto.RegisterType((*ObjectTracker)(nil), "api.ObjectTracker")
+	proto.RegisterType((*Directions)(nil), "api.Directions")
+	proto.RegisterType((*Address)(nil), "api.Address")
+	proto.RegisterType((*TrackerEvent)(nil), "api.TrackerEvent")
+	proto.RegisterType((*ObjectDetail)(nil), "api.ObjectDetail")
+	proto.RegisterType((*Geofence)(nil), "api.Geofence")
+	proto.RegisterMapType((map[string]string)(nil), "api.Geofence.MetadataEntry")
+	proto.RegisterType((*GeofenceEvent)(nil), "api.GeofenceEvent")
+	proto.RegisterType((*DwellState)(nil), "api.DwellState")
+	proto.RegisterType((*Webhook)(nil), "api.Webhook")
+	proto.RegisterType((*WebhookPayload)(nil), "api.WebhookPayload")
+	proto.RegisterType((*WebhookDelivery)(nil), "api.WebhookDelivery")
+	proto.RegisterType((*MetadataFilter)(nil), "api.MetadataFilter")
+	proto.RegisterType((*MetadataCondition)(nil), "api.MetadataCondition")
+	proto.RegisterType((*StreamRequest)(nil), "api.StreamRequest")
+	proto.RegisterType((*StreamResponse)(nil), "api.StreamResponse")
+	proto.RegisterType((*StreamRegexRequest)(nil), "api.StreamRegexRequest")
+	proto.RegisterType((*StreamRegexResponse)(nil), "api.StreamRegexResponse")
+	proto.RegisterType((*StreamPrefixRequest)(nil), "api.StreamPrefixRequest")
+	proto.RegisterType((*StreamPrefixResponse)(nil), "api.StreamPrefixResponse")
+	proto.RegisterType((*StreamBoundRequest)(nil), "api.StreamBoundRequest")
+	proto.RegisterType((*StreamBoundResponse)(nil), "api.StreamBoundResponse")
+	proto.RegisterType((*SetRequest)(nil), "api.SetRequest")
+	proto.RegisterType((*Precondition)(nil), "api.Precondition")
+	proto.RegisterType((*SetStreamRequest)(nil), "api.SetStreamRequest")
+	proto.RegisterType((*SetStreamResponse)(nil), "api.SetStreamResponse")
+	proto.RegisterType((*BatchSetRequest)(nil), "api.BatchSetRequest")
+	proto.RegisterType((*BatchSetResponse)(nil), "api.BatchSetResponse")
+	proto.RegisterType((*SetResponse)(nil), "api.SetResponse")
+	proto.RegisterType((*ObjectResult)(nil), "api.ObjectResult")
+	proto.RegisterType((*GetKeysRequest)(nil), "api.GetKeysRequest")
+	proto.RegisterType((*GetKeysResponse)(nil), "api.GetKeysResponse")
+	proto.RegisterType((*GetPrefixKeysRequest)(nil), "api.GetPrefixKeysRequest")
+	proto.RegisterType((*GetPrefixKeysResponse)(nil), "api.GetPrefixKeysResponse")
+	proto.RegisterType((*GetRegexKeysRequest)(nil), "api.GetRegexKeysRequest")
+	proto.RegisterType((*GetRegexKeysResponse)(nil), "api.GetRegexKeysResponse")
+	proto.RegisterType((*GetRequest)(nil), "api.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "api.GetResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetResponse.ObjectsEntry")
+	proto.RegisterType((*GetRegexRequest)(nil), "api.GetRegexRequest")
+	proto.RegisterType((*GetRegexResponse)(nil), "api.GetRegexResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetRegexResponse.ObjectsEntry")
+	proto.RegisterType((*GetPrefixRequest)(nil), "api.GetPrefixRequest")
+	proto.RegisterType((*GetPrefixResponse)(nil), "api.GetPrefixResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.GetPrefixResponse.ObjectsEntry")
+	proto.RegisterType((*DeleteRequest)(nil), "api.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "api.DeleteResponse")
+	proto.RegisterType((*ScanBoundRequest)(nil), "api.ScanBoundRequest")
+	proto.RegisterType((*ScanBoundResponse)(nil), "api.ScanBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPrefixBoundRequest)(nil), "api.ScanPrefixBoundRequest")
+	proto.RegisterType((*ScanPrefixBoundResponse)(nil), "api.ScanPrefixBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanRegexBoundRequest)(nil), "api.ScanRegexBoundRequest")
+	proto.RegisterType((*ScanRegexBoundResponse)(nil), "api.ScanRegexBoundResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoundResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPolygonRequest)(nil), "api.ScanPolygonRequest")
+	proto.RegisterType((*ScanPolygonResponse)(nil), "api.ScanPolygonResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPolygonResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPrefixPolygonRequest)(nil), "api.ScanPrefixPolygonRequest")
+	proto.RegisterType((*ScanPrefixPolygonResponse)(nil), "api.ScanPrefixPolygonResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixPolygonResponse.ObjectsEntry")
+	proto.RegisterType((*ScanRegexPolygonRequest)(nil), "api.ScanRegexPolygonRequest")
+	proto.RegisterType((*ScanRegexPolygonResponse)(nil), "api.ScanRegexPolygonResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexPolygonResponse.ObjectsEntry")
+	proto.RegisterType((*ScanBoxRequest)(nil), "api.ScanBoxRequest")
+	proto.RegisterType((*ScanBoxResponse)(nil), "api.ScanBoxResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanBoxResponse.ObjectsEntry")
+	proto.RegisterType((*ScanPrefixBoxRequest)(nil), "api.ScanPrefixBoxRequest")
+	proto.RegisterType((*ScanPrefixBoxResponse)(nil), "api.ScanPrefixBoxResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanPrefixBoxResponse.ObjectsEntry")
+	proto.RegisterType((*ScanRegexBoxRequest)(nil), "api.ScanRegexBoxRequest")
+	proto.RegisterType((*ScanRegexBoxResponse)(nil), "api.ScanRegexBoxResponse")
+	proto.RegisterMapType((map[string]*ObjectDetail)(nil), "api.ScanRegexBoxResponse.ObjectsEntry")
+	proto.RegisterType((*NearestRequest)(nil), "api.NearestRequest")
+	proto.RegisterType((*NearestResult)(nil), "api.NearestResult")
+	proto.RegisterType((*NearestResponse)(nil), "api.NearestResponse")
+	proto.RegisterType((*AddMetadataIndexRequest)(nil), "api.AddMetadataIndexRequest")
+	proto.RegisterType((*AddMetadataIndexResponse)(nil), "api.AddMetadataIndexResponse")
+	proto.RegisterType((*RemoveMetadataIndexRequest)(nil), "api.RemoveMetadataIndexRequest")
+	proto.RegisterType((*RemoveMetadataIndexResponse)(nil), "api.RemoveMetadataIndexResponse")
+	proto.RegisterType((*GetMetadataIndexesRequest)(nil), "api.GetMetadataIndexesRequest")
+	proto.RegisterType((*GetMetadataIndexesResponse)(nil), "api.GetMetadataIndexesResponse")
+	proto.RegisterType((*GetHistoryRequest)(nil), "api.GetHistoryRequest")
+	proto.RegisterType((*GetHistoryResponse)(nil), "api.GetHistoryResponse")
+	proto.RegisterType((*SetGeofenceRequest)(nil), "api.SetGeofenceRequest")
+	proto.RegisterType((*SetGeofenceResponse)(nil), "api.SetGeofenceResponse")
+	proto.RegisterType((*GetGeofencesRequest)(nil), "api.GetGeofencesRequest")
+	proto.RegisterType((*GetGeofencesResponse)(nil), "api.GetGeofencesResponse")
+	proto.RegisterMapType((map[string]*Geofence)(nil), "api.GetGeofencesResponse.GeofencesEntry")
+	proto.RegisterType((*DeleteGeofencesRequest)(nil), "api.DeleteGeofencesRequest")
+	proto.RegisterType((*DeleteGeofencesResponse)(nil), "api.DeleteGeofencesResponse")
+	proto.RegisterType((*StreamGeofenceEventsRequest)(nil), "api.StreamGeofenceEventsRequest")
+	proto.RegisterType((*StreamGeofenceEventsResponse)(nil), "api.StreamGeofenceEventsResponse")
+	proto.RegisterType((*SetWebhookRequest)(nil), "api.SetWebhookRequest")
+	proto.RegisterType((*SetWebhookResponse)(nil), "api.SetWebhookResponse")
+	proto.RegisterType((*GetWebhooksRequest)(nil), "api.GetWebhooksRequest")
+	proto.RegisterType((*GetWebhooksResponse)(nil), "api.GetWebhooksResponse")
+	proto.RegisterMapType((map[string]*Webhook)(nil), "api.GetWebhooksResponse.WebhooksEntry")
+	proto.RegisterType((*DeleteWebhooksRequest)(nil), "api.DeleteWebhooksRequest")
+	proto.RegisterType((*DeleteWebhooksResponse)(nil), "api.DeleteWebhooksResponse")
+	proto.RegisterType((*GetDeadLettersRequest)(nil), "api.GetDeadLettersRequest")
+	proto.RegisterType((*GetDeadLettersResponse)(nil), "api.GetDeadLettersResponse")
+	proto.RegisterType((*RedeliverDeadLettersRequest)(nil), "api.RedeliverDeadLettersRequest")
+	proto.RegisterType((*RedeliverDeadLettersResponse)(nil), "api.RedeliverDeadLettersResponse")
+	proto.RegisterType((*GetPointRequest)(nil), "api.GetPointRequest")
+	proto.RegisterType((*GetPointResponse)(nil), "api.GetPointResponse")
+	proto.RegisterType((*MapsCacheStats)(nil), "api.MapsCacheStats")
+	proto.RegisterType((*GetMapsCacheStatsRequest)(nil), "api.GetMapsCacheStatsRequest")
+	proto.RegisterType((*GetMapsCacheStatsResponse)(nil), "api.GetMapsCacheStatsResponse")
+	proto.RegisterType((*PurgeMapsCacheRequest)(nil), "api.PurgeMapsCacheRequest")
+	proto.RegisterType((*PurgeMapsCacheResponse)(nil), "api.PurgeMapsCacheResponse")
+	proto.RegisterType((*BoundMapsCacheRequest)(nil), "api.BoundMapsCacheRequest")
+	proto.RegisterType((*BoundMapsCacheResponse)(nil), "api.BoundMapsCacheResponse")
+	proto.RegisterType((*PingRequest)(nil), "api.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "api.PingResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 4461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x5d, 0x6f, 0x1c, 0x59,
+	0x56, 0xae, 0x6e, 0xb7, 0xdd, 0x7d, 0xec, 0x6e, 0x97, 0xaf, 0x3f, 0xd2, 0x2e, 0x27, 0x13, 0x4f,
+	0x65, 0x27, 0x93, 0x38, 0x93, 0x8f, 0xf1, 0xce, 0x64, 0x76, 0x66, 0x32, 0x4a, 0xe2, 0xd8, 0xeb,
+	0x44, 0x43, 0x3e, 0xa8, 0x64, 0x77, 0x60, 0xa5, 0xdd, 0xde, 0x4a, 0xd7, 0x75, 0xbb, 0x70, 0x77,
+	0x55, 0x4f, 0xd5, 0xb5, 0x63, 0x0f, 0x42, 0x42, 0x3c, 0x20, 0xb1, 0x12, 0x0f, 0x20, 0xf1, 0xb2,
+	0x6f, 0x48, 0xf0, 0x00, 0x2b, 0xc1, 0x03, 0x12, 0x6f, 0xf0, 0xb0, 0x62, 0x85, 0x56, 0x80, 0x10,
+	0x20, 0x84, 0x56, 0x42, 0x1a, 0x31, 0xf0, 0x3b, 0x00, 0xdd, 0xcf, 0xba, 0xb7, 0xba, 0xba, 0x6d,
+	0xcf, 0x24, 0xb3, 0xf6, 0x53, 0xdf, 0x73, 0xcf, 0x3d, 0x75, 0xbe, 0xee, 0xb9, 0xa7, 0xce, 0x3d,
+	0x65, 0xa8, 0xf9, 0xfd, 0xf0, 0x5a, 0x3f, 0x89, 0x49, 0x8c, 0xca, 0x7e, 0x3f, 0x74, 0x6e, 0x76,
+	0x42, 0xb2, 0xb3, 0xf7, 0xfc, 0x5a, 0x3b, 0xee, 0x5d, 0xef, 0xbd, 0x08, 0xc9, 0x6e, 0xfc, 0xe2,
+	0x7a, 0x27, 0xbe, 0xca, 0x30, 0xae, 0xee, 0xfb, 0xdd, 0x30, 0xf0, 0x49, 0x9c, 0xa4, 0xd7, 0xd5,
+	0x4f, 0xbe, 0xd8, 0xbd, 0x02, 0x95, 0x27, 0x71, 0x18, 0x11, 0x64, 0x43, 0xb9, 0xeb, 0x93, 0xa6,
+	0xb5, 0x62, 0x5d, 0xb2, 0x3c, 0xfa, 0x93, 0x41, 0xe2, 0xa8, 0x59, 0x12, 0x90, 0x38, 0x72, 0xef,
+	0x41, 0x65, 0x3d, 0xde, 0x8b, 0x02, 0xe4, 0xc2, 0x44, 0x1b, 0x47, 0x04, 0x27, 0x0c, 0x7f, 0x6a,
+	0x0d, 0xae, 0x51, 0x76, 0x18, 0x21, 0x4f, 0xcc, 0xa0, 0x45, 0x98, 0x48, 0xfc, 0x20, 0xdc, 0x4b,
+	0x05, 0x05, 0x31, 0x72, 0xd7, 0x60, 0xdc, 0x0b, 0xa3, 0x0e, 0x5a, 0x85, 0x89, 0x3e, 0x5d, 0x90,
+	0x36, 0xad, 0x95, 0xb2, 0x49, 0x63, 0x7d, 0xe2, 0x8b, 0xcf, 0xcf, 0x97, 0x7e, 0x58, 0xf6, 0x04,
+	0x86, 0xbb, 0x06, 0x93, 0x4f, 0xe2, 0xee, 0x61, 0x27, 0x8e, 0xd0, 0x9b, 0x50, 0x49, 0xc2, 0xa8,
+	0x23, 0x57, 0xd5, 0xd8, 0x2a, 0x4a, 0x50, 0x2c, 0xb2, 0x3c, 0x3e, 0xef, 0xee, 0x42, 0x79, 0x3d,
+	0x3e, 0x40, 0x6f, 0x03, 0xa4, 0xf1, 0x1e, 0xd9, 0x69, 0xbd, 0xc0, 0x29, 0x19, 0x64, 0x97, 0xaf,
+	0x5a, 0xb1, 0xbc, 0x1a, 0xc3, 0xfa, 0x04, 0xa7, 0x84, 0x2e, 0x89, 0xe2, 0x84, 0xec, 0xb4, 0xb0,
+	0x9f, 0x92, 0x66, 0x69, 0xf8, 0x12, 0x86, 0xb5, 0xe9, 0xa7, 0xc4, 0xfd, 0xd3, 0x32, 0x4c, 0x3c,
+	0x7e, 0xfe, 0x1b, 0xb8, 0x4d, 0x90, 0x0b, 0xe5, 0x5d, 0x7c, 0xc8, 0x9e, 0x54, 0x5b, 0xb7, 0xbf,
+	0xf8, 0xfc, 0xfc, 0x34, 0xc0, 0x0f, 0xae, 0xfd, 0xe6, 0xdb, 0x6f, 0xad, 0xad, 0xbd, 0xfb, 0x5b,
+	0xdf, 0xf0, 0xe8, 0x24, 0xba, 0x04, 0x15, 0x26, 0xd9, 0x08, 0xe2, 0x1c, 0x01, 0xbd, 0xa6, 0xb4,
+	0x58, 0x5e, 0xb1, 0x2e, 0x95, 0xf9, 0xb4, 0x3d, 0x26, 0xb5, 0x89, 0xae, 0x43, 0x95, 0x24, 0x7e,
+	0x7b, 0x37, 0x8c, 0x3a, 0xcd, 0x71, 0x46, 0x6c, 0x8e, 0x11, 0xe3, 0xcc, 0x3c, 0x13, 0x53, 0x9e,
+	0x42, 0x42, 0xef, 0x42, 0xb5, 0x87, 0x89, 0x1f, 0xf8, 0xc4, 0x6f, 0x56, 0x98, 0x0a, 0x97, 0xb4,
+	0x05, 0xd7, 0x1e, 0x8a, 0xb9, 0xcd, 0x88, 0x24, 0x87, 0x9e, 0x42, 0x45, 0xe7, 0x61, 0xaa, 0x83,
+	0x49, 0xcb, 0x0f, 0x82, 0x04, 0xa7, 0x69, 0x73, 0x62, 0xc5, 0xba, 0x54, 0xf5, 0xa0, 0x83, 0xc9,
+	0x5d, 0x0e, 0x41, 0xaf, 0xc3, 0x34, 0x45, 0x20, 0x61, 0x0f, 0x7f, 0x16, 0x47, 0xb8, 0x39, 0xc9,
+	0x30, 0xe8, 0xa2, 0x67, 0x02, 0x44, 0x51, 0xf0, 0x41, 0x3f, 0x4c, 0x70, 0xda, 0xda, 0x8b, 0xc2,
+	0x83, 0x66, 0x95, 0x4a, 0xe4, 0x4d, 0x09, 0xd8, 0x77, 0xa2, 0xf0, 0x80, 0xa2, 0xec, 0xf5, 0x03,
+	0x9f, 0xe0, 0x80, 0xa3, 0xd4, 0x38, 0x8a, 0x80, 0x51, 0x14, 0xe7, 0x43, 0xa8, 0x1b, 0x4c, 0x22,
+	0x5b, 0x53, 0x38, 0x57, 0xef, 0x3c, 0x54, 0xf6, 0xfd, 0xee, 0x1e, 0x66, 0xea, 0xad, 0x79, 0x7c,
+	0xf0, 0x41, 0xe9, 0x5b, 0x96, 0x9b, 0x40, 0xc3, 0xd4, 0x0c, 0xba, 0x01, 0x53, 0x24, 0xf1, 0xf7,
+	0x71, 0xb7, 0xd5, 0x8b, 0x03, 0xcc, 0xa8, 0x34, 0xd6, 0x66, 0x98, 0x4a, 0x9e, 0x31, 0xf8, 0xc3,
+	0x38, 0xc0, 0x1e, 0x10, 0xf5, 0x1b, 0x5d, 0x13, 0x2a, 0xc7, 0x09, 0x75, 0x6d, 0xaa, 0x41, 0x94,
+	0x57, 0x39, 0x4e, 0x3c, 0x85, 0xe3, 0xfe, 0x97, 0x05, 0x75, 0x63, 0x0e, 0xdd, 0x82, 0x59, 0xe2,
+	0x27, 0x54, 0x5d, 0x31, 0x83, 0xb7, 0x46, 0x39, 0xcc, 0x0c, 0x47, 0xe5, 0x14, 0x3e, 0xc6, 0x87,
+	0xe8, 0x32, 0xd8, 0x8c, 0x76, 0x2b, 0x08, 0x13, 0xdc, 0x26, 0x61, 0x1c, 0xf1, 0x2d, 0x56, 0xf5,
+	0x66, 0x18, 0x7c, 0x43, 0x81, 0xd1, 0x1b, 0xd0, 0x90, 0xa8, 0x29, 0xf1, 0xa3, 0x36, 0x66, 0x5e,
+	0x54, 0xf5, 0xea, 0x02, 0x91, 0x03, 0xd1, 0x32, 0xd4, 0x38, 0x1a, 0x26, 0x3e, 0xf3, 0xa2, 0xaa,
+	0x60, 0x7f, 0x93, 0xf8, 0xe8, 0x02, 0xd4, 0x83, 0x17, 0xb8, 0xdb, 0x6d, 0xa5, 0xb8, 0x1d, 0x47,
+	0x41, 0xda, 0xac, 0x30, 0x9b, 0x4c, 0x33, 0xe0, 0x53, 0x0e, 0x73, 0x77, 0x00, 0xb4, 0xc7, 0xbe,
+	0x09, 0x33, 0x3b, 0xa4, 0xd7, 0xd5, 0x19, 0xe4, 0xd6, 0x69, 0x50, 0xb0, 0x86, 0x68, 0x43, 0x99,
+	0x3e, 0xb2, 0xc4, 0x28, 0x96, 0x31, 0xf7, 0x33, 0x61, 0x0e, 0xca, 0x32, 0x77, 0x7a, 0xa9, 0x7d,
+	0xca, 0xaf, 0xfb, 0x07, 0x16, 0x4c, 0x4a, 0x9f, 0x9b, 0x87, 0x4a, 0x4a, 0x7c, 0x82, 0x05, 0x75,
+	0x3e, 0x40, 0x4d, 0x98, 0x94, 0x6e, 0xca, 0xed, 0x2f, 0x87, 0x74, 0xa6, 0x1d, 0xef, 0x51, 0xa7,
+	0x61, 0x84, 0x6b, 0x9e, 0x1c, 0x52, 0x46, 0x3e, 0x0b, 0xfb, 0x4c, 0xf6, 0x9a, 0x47, 0x7f, 0xd2,
+	0xf0, 0xc5, 0x26, 0x0f, 0x99, 0xbc, 0x35, 0x4f, 0x8c, 0x10, 0x82, 0xf1, 0x76, 0x48, 0x0e, 0xd9,
+	0x0e, 0xa8, 0x79, 0xec, 0xb7, 0xfb, 0xbf, 0x16, 0x4c, 0x0b, 0xdb, 0x6e, 0xee, 0xe3, 0x88, 0xa0,
+	0x0b, 0x30, 0xc1, 0x2d, 0x2b, 0x02, 0xce, 0x94, 0xe6, 0x20, 0x9e, 0x98, 0x42, 0x0e, 0x54, 0x95,
+	0x59, 0x78, 0x88, 0x54, 0x63, 0xfa, 0xf4, 0x30, 0x4a, 0xc3, 0x40, 0x1a, 0x4c, 0x8c, 0xd0, 0x55,
+	0xa8, 0x29, 0xa5, 0x8a, 0xfd, 0xce, 0x7d, 0x35, 0x53, 0xaa, 0x97, 0x61, 0x30, 0xfb, 0x87, 0x3d,
+	0x9c, 0x12, 0xbf, 0xd7, 0xe7, 0x1b, 0x8a, 0x1b, 0xaf, 0xae, 0xa0, 0x6c, 0xd7, 0x0d, 0x98, 0x78,
+	0x62, 0xd0, 0xc4, 0x8c, 0x5d, 0x3a, 0xa6, 0x91, 0x86, 0x6f, 0x6e, 0x35, 0x76, 0xff, 0xae, 0x04,
+	0xd3, 0x5c, 0xba, 0x0d, 0x4c, 0xfc, 0xb0, 0x7b, 0x3c, 0x05, 0x5c, 0x34, 0x0d, 0x35, 0xb5, 0x36,
+	0xcd, 0xb0, 0x84, 0x75, 0x33, 0xb3, 0x39, 0x50, 0x55, 0x61, 0x85, 0xdb, 0x4d, 0x8d, 0xd1, 0xb7,
+	0x84, 0x87, 0xe3, 0xa4, 0x85, 0xa9, 0xea, 0xd3, 0xe6, 0x38, 0xdb, 0x92, 0xb3, 0x72, 0x07, 0x2b,
+	0xa3, 0x08, 0xa7, 0x17, 0x23, 0x46, 0x35, 0xc5, 0x9f, 0xee, 0x61, 0xaa, 0x7e, 0xaa, 0x95, 0x71,
+	0x4f, 0x8d, 0xd1, 0x2a, 0x54, 0x18, 0x35, 0xa6, 0x88, 0xc6, 0xda, 0xbc, 0xc6, 0x3d, 0x5b, 0xfd,
+	0xec, 0xb0, 0x8f, 0x3d, 0x8e, 0x42, 0x9d, 0x6a, 0x1f, 0x27, 0x29, 0x35, 0xc8, 0x24, 0x23, 0x23,
+	0x87, 0xe8, 0x1c, 0xc0, 0x1e, 0x69, 0xb7, 0xe2, 0xed, 0xed, 0x14, 0x13, 0x16, 0xed, 0x2a, 0x5e,
+	0x6d, 0x8f, 0xb4, 0x1f, 0x33, 0x00, 0xf5, 0xb9, 0x20, 0x25, 0x2c, 0xc4, 0x55, 0x3d, 0xfa, 0xd3,
+	0xfd, 0xa3, 0x12, 0x54, 0xb7, 0x70, 0xbc, 0xcd, 0x78, 0x38, 0xce, 0x39, 0x42, 0xcf, 0xe1, 0x30,
+	0x69, 0x77, 0xb1, 0x71, 0x90, 0xb0, 0x33, 0xda, 0x13, 0x33, 0x54, 0xcb, 0x7d, 0x7e, 0x76, 0x36,
+	0xcb, 0x9a, 0x96, 0xc5, 0x79, 0xea, 0xc9, 0x49, 0xf4, 0x9e, 0x76, 0x30, 0x70, 0x1d, 0x2e, 0x33,
+	0x44, 0xc9, 0xd0, 0xd0, 0xa3, 0xe1, 0x38, 0x01, 0xe2, 0xab, 0x45, 0xed, 0xff, 0xb1, 0xa0, 0x2e,
+	0xd9, 0xe0, 0x1b, 0xec, 0x32, 0x54, 0x3b, 0x02, 0x20, 0x3c, 0xac, 0x6e, 0x30, 0xeb, 0xa9, 0x69,
+	0xcd, 0x15, 0x4b, 0xc3, 0x5d, 0xf1, 0x3d, 0xa0, 0x31, 0x26, 0x4a, 0x43, 0x12, 0x0a, 0x3d, 0x35,
+	0xd6, 0xce, 0x18, 0x14, 0x9f, 0xa9, 0x69, 0x4f, 0x43, 0x2d, 0xd8, 0x61, 0xe3, 0xc7, 0xda, 0x61,
+	0x45, 0x41, 0xf4, 0x8f, 0x2d, 0x80, 0x0d, 0x06, 0x60, 0x71, 0x0c, 0xc1, 0xf8, 0x6e, 0x18, 0x05,
+	0x42, 0x45, 0xec, 0xb7, 0xd4, 0x5a, 0x29, 0xd3, 0xda, 0x22, 0x4c, 0xf0, 0x03, 0x42, 0x6c, 0x0d,
+	0x31, 0x62, 0x87, 0x2d, 0xcd, 0xc3, 0x70, 0xa0, 0xb3, 0x35, 0x25, 0x60, 0x8c, 0xa9, 0xb3, 0x50,
+	0x23, 0x3b, 0x09, 0x4e, 0x77, 0xe2, 0x6e, 0x20, 0x18, 0xca, 0x00, 0xd4, 0x1c, 0xdb, 0x61, 0x82,
+	0x03, 0x71, 0xd6, 0xf3, 0x01, 0x35, 0xc5, 0xe4, 0x27, 0xf8, 0xf9, 0x4e, 0x1c, 0xef, 0xa2, 0x15,
+	0x28, 0x85, 0xc1, 0x50, 0x07, 0x2d, 0x85, 0x01, 0x7a, 0x03, 0xca, 0x7b, 0x49, 0x97, 0xb3, 0xbb,
+	0x3e, 0xf7, 0xc5, 0xe7, 0xe7, 0x67, 0xa0, 0xfe, 0x83, 0x1d, 0x42, 0xfa, 0xe9, 0xed, 0x0f, 0xae,
+	0x5f, 0xbf, 0x76, 0xe5, 0x1b, 0x1e, 0x9d, 0x67, 0x92, 0xe2, 0x43, 0x9a, 0xe2, 0x94, 0x99, 0xa4,
+	0xf8, 0x30, 0xa5, 0x72, 0xf5, 0x13, 0xbc, 0x2d, 0x38, 0xaf, 0x79, 0x62, 0x44, 0xd9, 0x4a, 0x70,
+	0x07, 0x1f, 0x88, 0xb0, 0xcc, 0x07, 0xe8, 0x26, 0x4c, 0xb1, 0xdd, 0xd8, 0x22, 0x87, 0x7d, 0x4c,
+	0xe3, 0x57, 0xf9, 0x52, 0x63, 0x6d, 0x81, 0x19, 0x50, 0x70, 0x9b, 0xed, 0x5b, 0xc0, 0xf2, 0x27,
+	0x7b, 0x4a, 0x8a, 0xdb, 0x09, 0x26, 0x6c, 0xef, 0xd6, 0x3c, 0x31, 0x72, 0xff, 0xdb, 0x82, 0x86,
+	0x58, 0xf8, 0xc4, 0x3f, 0xec, 0xc6, 0x7e, 0x80, 0x1a, 0x99, 0xb4, 0x4c, 0xb6, 0x77, 0x00, 0xb2,
+	0x47, 0x32, 0x11, 0x87, 0x3e, 0xb1, 0xa6, 0x9e, 0x58, 0xe0, 0x2f, 0xe5, 0x22, 0x7f, 0xb9, 0xac,
+	0x9c, 0x96, 0x07, 0xf9, 0x59, 0xcd, 0x69, 0x79, 0x88, 0x55, 0xae, 0xfb, 0x3e, 0x34, 0xa4, 0xaf,
+	0xf3, 0x10, 0xc8, 0x34, 0x23, 0x93, 0x12, 0x63, 0xdb, 0x78, 0xf5, 0x8e, 0x3e, 0x74, 0xff, 0xc1,
+	0x82, 0x19, 0xc1, 0xec, 0x06, 0xee, 0x86, 0xfb, 0x38, 0x39, 0x1c, 0x10, 0xf3, 0x1c, 0xc0, 0x0b,
+	0x8e, 0xd2, 0x0a, 0x03, 0xe1, 0x78, 0x35, 0x01, 0x79, 0x10, 0xa0, 0xab, 0x30, 0xd9, 0xe7, 0x0a,
+	0x6a, 0x96, 0xb5, 0xf4, 0xd3, 0xd4, 0x9d, 0x27, 0x71, 0x68, 0xd0, 0xf5, 0x09, 0xc1, 0xbd, 0x3e,
+	0x0b, 0xd4, 0x54, 0x70, 0x35, 0xa6, 0x4f, 0xea, 0xfa, 0x29, 0x69, 0xe1, 0x24, 0x89, 0x13, 0x61,
+	0xde, 0x1a, 0x85, 0x6c, 0x52, 0x00, 0xcd, 0x0c, 0xb6, 0xfd, 0xb0, 0x2b, 0xfd, 0x99, 0x1f, 0x51,
+	0xc0, 0x41, 0x54, 0x67, 0xee, 0x7d, 0x68, 0xc8, 0x10, 0xf3, 0xed, 0xb0, 0x4b, 0x5f, 0x41, 0x6e,
+	0x02, 0xd0, 0x9d, 0x15, 0xca, 0x14, 0x84, 0x06, 0xb5, 0x45, 0xc6, 0x9f, 0x44, 0xbc, 0x27, 0xa7,
+	0x3d, 0x0d, 0xd3, 0xfd, 0x1d, 0x0b, 0x66, 0x07, 0x30, 0x8e, 0x15, 0x90, 0xdf, 0x86, 0x6a, 0xdc,
+	0xc7, 0x09, 0x7d, 0xc1, 0x32, 0x5c, 0x42, 0x52, 0x7b, 0x2c, 0x26, 0x3d, 0x85, 0x46, 0x5d, 0x90,
+	0x45, 0x3a, 0xe9, 0xfe, 0x62, 0xe4, 0xfe, 0xa1, 0x05, 0xf5, 0xa7, 0x24, 0xc1, 0x7e, 0xcf, 0xa3,
+	0xc7, 0x52, 0x4a, 0x68, 0x9a, 0xd6, 0xee, 0x86, 0xd4, 0xe5, 0x94, 0x85, 0xaa, 0x1c, 0xf0, 0x20,
+	0x50, 0x7b, 0xa8, 0xa4, 0xed, 0xa1, 0x2b, 0x30, 0xb1, 0xcd, 0x34, 0x61, 0xd8, 0xc6, 0x54, 0x92,
+	0x27, 0x50, 0x68, 0x88, 0xda, 0x4e, 0xe2, 0x5e, 0x4b, 0x1d, 0x8a, 0xe3, 0xec, 0x34, 0x9b, 0xa6,
+	0xc0, 0xa7, 0x02, 0xe6, 0x76, 0xa0, 0x21, 0x79, 0x4a, 0xfb, 0x71, 0x94, 0x62, 0xcd, 0x53, 0xad,
+	0xa3, 0x3c, 0x55, 0x9d, 0xaa, 0xa5, 0x23, 0x4f, 0x55, 0xf7, 0x27, 0x16, 0x20, 0xf9, 0xa4, 0x0e,
+	0x3e, 0x38, 0x96, 0x0a, 0x2e, 0xca, 0xd0, 0x50, 0x1a, 0x62, 0x22, 0x3e, 0xfd, 0x0a, 0xd4, 0xd2,
+	0x85, 0x39, 0x83, 0xd9, 0x57, 0xab, 0x9b, 0xbf, 0xb0, 0xe4, 0xe3, 0x9e, 0xb0, 0x98, 0x78, 0x2c,
+	0xe5, 0x5c, 0x52, 0xf1, 0x74, 0x98, 0x76, 0xc4, 0xfc, 0x2b, 0x50, 0x4f, 0x0f, 0xe6, 0x4d, 0x7e,
+	0x5f, 0xad, 0x7e, 0xfe, 0x5c, 0xf9, 0x0e, 0xcf, 0x84, 0x8e, 0xa3, 0x9e, 0x97, 0x99, 0x49, 0x65,
+	0x0a, 0x1c, 0x3f, 0x52, 0x81, 0xee, 0x6f, 0x2b, 0x63, 0x0a, 0x66, 0x5f, 0xa9, 0x6e, 0x68, 0x98,
+	0xe8, 0xe2, 0x6d, 0x22, 0x5e, 0x2b, 0xd8, 0x6f, 0xb7, 0x0f, 0xf0, 0x14, 0x13, 0xa9, 0xa6, 0x2b,
+	0x23, 0x52, 0x77, 0x55, 0x9d, 0x90, 0x8f, 0x7e, 0x17, 0xa6, 0xfb, 0x09, 0x56, 0xa1, 0xb3, 0x59,
+	0xd2, 0x78, 0x7d, 0xa2, 0x4d, 0x78, 0x06, 0x9a, 0xbb, 0x07, 0xd3, 0xfa, 0x2c, 0x7d, 0xa5, 0xc5,
+	0x07, 0x7d, 0xdc, 0xa6, 0xef, 0xfd, 0x32, 0x99, 0xb6, 0x98, 0x23, 0xcd, 0x48, 0xf8, 0x77, 0x39,
+	0x18, 0x7d, 0x00, 0x4e, 0xb8, 0xdd, 0x8a, 0xf0, 0x0b, 0x9c, 0xb4, 0xc8, 0x8e, 0x1f, 0xb5, 0x8c,
+	0x7a, 0x01, 0x7f, 0x0f, 0x5e, 0x0c, 0xb7, 0x1f, 0x51, 0x84, 0x67, 0x3b, 0x7e, 0xf4, 0x9d, 0xac,
+	0x74, 0xe0, 0xfe, 0xae, 0x05, 0xf6, 0x53, 0x4c, 0xcc, 0xa8, 0x9a, 0x3f, 0xf0, 0xae, 0x8c, 0xc8,
+	0x17, 0x8f, 0x94, 0xbf, 0x7c, 0x3c, 0xf9, 0xff, 0xd2, 0x82, 0x59, 0x8d, 0x11, 0x61, 0xf2, 0x3c,
+	0x27, 0x83, 0xc9, 0x9e, 0xfe, 0xce, 0x52, 0xce, 0xbd, 0xb3, 0xd0, 0x17, 0x53, 0x5a, 0xc1, 0xa0,
+	0x3e, 0x57, 0xf7, 0xd8, 0x6f, 0x9a, 0x2c, 0xe9, 0xa7, 0x29, 0x1f, 0xe8, 0x6f, 0x2c, 0x13, 0xe6,
+	0x1b, 0x4b, 0x13, 0x26, 0xd3, 0xdd, 0xb0, 0xdf, 0xc7, 0x81, 0x78, 0xc5, 0x93, 0x43, 0xf7, 0x0e,
+	0xcc, 0xac, 0xfb, 0xa4, 0xbd, 0xa3, 0x39, 0xca, 0x55, 0x98, 0xe4, 0x5a, 0x90, 0x47, 0xeb, 0xa0,
+	0xa6, 0x7e, 0x68, 0x79, 0x12, 0xc7, 0xbd, 0x0d, 0x76, 0x46, 0x41, 0x48, 0x7c, 0x25, 0x4f, 0xa2,
+	0xc0, 0xcb, 0x15, 0x01, 0x0f, 0xa6, 0xf4, 0xb5, 0x27, 0xd8, 0x20, 0x9a, 0x58, 0x25, 0x53, 0xac,
+	0x5f, 0x93, 0xef, 0xad, 0x1e, 0x4e, 0xf7, 0xba, 0xe4, 0x24, 0x44, 0xcf, 0x01, 0xf4, 0xfd, 0x0e,
+	0x6e, 0x91, 0x78, 0x17, 0x47, 0x32, 0x31, 0xa2, 0x90, 0x67, 0x14, 0xe0, 0x7e, 0x1f, 0x1a, 0x5b,
+	0x98, 0xd6, 0x6b, 0x52, 0xa9, 0xaf, 0xb7, 0x80, 0x4d, 0xb7, 0xd2, 0xf0, 0x33, 0xfe, 0xd2, 0x52,
+	0x59, 0x9f, 0xf9, 0xe2, 0xf3, 0xf3, 0x53, 0xf6, 0xff, 0xc9, 0x3f, 0xcb, 0xab, 0x52, 0x8c, 0xa7,
+	0xe1, 0x67, 0xf8, 0x28, 0xf2, 0x0f, 0x61, 0x46, 0x91, 0x17, 0x0a, 0x91, 0x19, 0x80, 0xa5, 0x65,
+	0x00, 0x17, 0x61, 0x26, 0xc2, 0x07, 0xa4, 0x35, 0x40, 0xaa, 0x4e, 0xc1, 0x4f, 0x14, 0xb9, 0xdf,
+	0xb7, 0x60, 0x7e, 0x0b, 0x13, 0x1e, 0x9f, 0x75, 0xa6, 0xb3, 0x63, 0xc3, 0x3a, 0xe2, 0xd8, 0x30,
+	0xc4, 0x2b, 0x9d, 0x4c, 0xbc, 0x72, 0x5e, 0xbc, 0xa7, 0xb0, 0x90, 0x63, 0xe7, 0x25, 0x08, 0xf9,
+	0x23, 0x0b, 0xe6, 0xb6, 0x30, 0x61, 0x67, 0xb4, 0x2e, 0xa3, 0xca, 0x1b, 0xac, 0xd1, 0x79, 0xc3,
+	0x4b, 0x95, 0xd0, 0x83, 0x79, 0x93, 0x97, 0x97, 0x20, 0xe0, 0x8f, 0x2d, 0x80, 0xad, 0x6c, 0x83,
+	0x16, 0x91, 0xca, 0xce, 0xa6, 0xd2, 0xd1, 0x87, 0xbb, 0x21, 0x70, 0xf9, 0x64, 0x02, 0x8f, 0xe7,
+	0x05, 0xfe, 0x1b, 0x0b, 0xa6, 0xb6, 0xb4, 0xfd, 0xfb, 0x5e, 0x7e, 0xef, 0x9f, 0x13, 0x2f, 0x2c,
+	0x0a, 0x45, 0xec, 0xbb, 0x94, 0x17, 0x1c, 0x24, 0xf6, 0x71, 0xb5, 0xe1, 0x3c, 0x84, 0x69, 0x9d,
+	0x40, 0x41, 0xc5, 0xe1, 0x4d, 0xbd, 0xe2, 0x50, 0xb8, 0xd9, 0xb5, 0x22, 0xc4, 0x5f, 0x59, 0x30,
+	0x23, 0x2d, 0x76, 0x52, 0xcf, 0xf9, 0xe5, 0x69, 0xfd, 0xef, 0x2d, 0xb0, 0x33, 0xae, 0x85, 0xea,
+	0x6f, 0xe5, 0x55, 0xef, 0x66, 0xaa, 0xd7, 0xf0, 0x4e, 0x97, 0xfe, 0xff, 0x9a, 0x4b, 0x62, 0xa6,
+	0xbc, 0xc7, 0x0f, 0x4f, 0xbf, 0x3c, 0x13, 0xfc, 0xdc, 0x82, 0x59, 0x8d, 0x71, 0x61, 0x83, 0x8f,
+	0xf2, 0x36, 0xb8, 0x20, 0x6d, 0x60, 0x22, 0x9e, 0x2e, 0x23, 0x5c, 0x80, 0xfa, 0x06, 0xee, 0x62,
+	0x82, 0x47, 0xc4, 0x18, 0xd7, 0x86, 0x86, 0x44, 0xe2, 0x32, 0xb8, 0x3f, 0xa5, 0x89, 0x57, 0xdb,
+	0x8f, 0x8c, 0x7c, 0x7c, 0x05, 0x2a, 0xcf, 0xe9, 0xd8, 0xb8, 0x94, 0xe3, 0x18, 0x7c, 0xe2, 0xab,
+	0xbf, 0xd3, 0x1a, 0x76, 0x1c, 0x3f, 0x99, 0x1d, 0x2b, 0x45, 0x76, 0xd4, 0x84, 0x18, 0x6d, 0xc7,
+	0x01, 0xc4, 0xd3, 0x65, 0xc7, 0x7f, 0xb4, 0x60, 0x91, 0xb2, 0xc8, 0x7d, 0xed, 0x84, 0x66, 0x59,
+	0x34, 0x5f, 0x25, 0xbf, 0xdc, 0x8b, 0xe3, 0x4b, 0x35, 0xcd, 0xbf, 0x5b, 0x70, 0x66, 0x40, 0x1c,
+	0x61, 0xa0, 0x7b, 0x79, 0x03, 0x5d, 0x56, 0x06, 0x2a, 0x40, 0x3f, 0x5d, 0x66, 0xfa, 0xb9, 0x05,
+	0x0b, 0x94, 0x51, 0x16, 0x96, 0x4f, 0x68, 0xa5, 0x79, 0xa3, 0x1a, 0xf2, 0xa5, 0x6a, 0x1f, 0x2f,
+	0xd5, 0x46, 0xff, 0x26, 0x5c, 0x4e, 0x97, 0x45, 0x98, 0x68, 0x3d, 0x6f, 0xa2, 0x4b, 0xca, 0x44,
+	0x83, 0xd8, 0xa7, 0xcb, 0x42, 0xff, 0x4a, 0x6b, 0x0d, 0xd4, 0x95, 0x44, 0x11, 0x40, 0x98, 0xe7,
+	0x5a, 0x56, 0x2a, 0xb0, 0x06, 0x4b, 0x05, 0xea, 0x35, 0x52, 0x22, 0x1d, 0x11, 0xe9, 0x2a, 0x5f,
+	0xf3, 0x89, 0xf5, 0x4f, 0xb4, 0x26, 0xa1, 0x0b, 0x25, 0xec, 0x74, 0x3b, 0x6f, 0xa7, 0x37, 0xb2,
+	0xad, 0x64, 0xa2, 0x9e, 0x2e, 0x23, 0xfd, 0xa7, 0x05, 0xcd, 0x6c, 0xbf, 0x7f, 0x45, 0x53, 0x1d,
+	0x1d, 0xfd, 0xbe, 0x6e, 0x73, 0xfd, 0xc2, 0x82, 0xa5, 0x02, 0xf1, 0x84, 0xd1, 0x36, 0xf3, 0x46,
+	0xbb, 0x92, 0x8b, 0x7f, 0xa7, 0xda, 0x74, 0xbf, 0x10, 0x91, 0x9d, 0xc5, 0x81, 0xaf, 0x68, 0xb9,
+	0xa3, 0x22, 0xe2, 0xd7, 0x6d, 0xb7, 0xff, 0x10, 0x6e, 0x69, 0xca, 0x26, 0xcc, 0xb6, 0x91, 0x37,
+	0xdb, 0xaa, 0x19, 0x13, 0x4f, 0xb5, 0xd5, 0x7e, 0x6a, 0x41, 0x83, 0x67, 0x40, 0x2a, 0x53, 0x77,
+	0xa1, 0xfc, 0x3c, 0x3e, 0x10, 0x86, 0xaa, 0x8a, 0xe3, 0xea, 0x40, 0x19, 0x89, 0x4e, 0x9e, 0xb2,
+	0x28, 0xf8, 0x33, 0x0b, 0x66, 0x94, 0x10, 0xc2, 0x2a, 0x1f, 0xe6, 0xad, 0xf2, 0xba, 0x96, 0xed,
+	0x1d, 0x9c, 0xda, 0x5c, 0x6f, 0x5e, 0xcf, 0x76, 0x4e, 0x64, 0x92, 0xd3, 0x17, 0xed, 0xfe, 0x45,
+	0xe4, 0x44, 0x9a, 0x38, 0xc2, 0x38, 0x77, 0xf3, 0xc6, 0x79, 0x73, 0x20, 0xd3, 0x3b, 0x38, 0xb5,
+	0x79, 0xde, 0x9c, 0x96, 0xed, 0x9c, 0xc8, 0x42, 0xa7, 0x2d, 0xaa, 0xfd, 0xb3, 0x70, 0xb7, 0x4c,
+	0x16, 0x61, 0x9e, 0x3b, 0x79, 0xf3, 0x5c, 0xcc, 0x67, 0x79, 0xa7, 0xd4, 0x3a, 0x7f, 0x66, 0x41,
+	0xe3, 0x11, 0xf6, 0x13, 0x9c, 0x92, 0xac, 0xee, 0x20, 0x1a, 0x38, 0xad, 0xa3, 0x1a, 0x38, 0xcf,
+	0x42, 0xa5, 0x1b, 0xf6, 0x42, 0x7e, 0x9b, 0x90, 0xf5, 0x6f, 0x72, 0x20, 0xed, 0xd2, 0xe8, 0xf9,
+	0x07, 0x66, 0x7b, 0x9e, 0xe5, 0x4d, 0xf5, 0xfc, 0x83, 0x0d, 0xad, 0x15, 0xec, 0xf8, 0x8d, 0x10,
+	0xee, 0x77, 0xa1, 0xae, 0x58, 0x3d, 0x69, 0x45, 0x7b, 0x44, 0x43, 0x9a, 0x7b, 0x1b, 0x66, 0x32,
+	0xba, 0xdc, 0x9e, 0x6f, 0xc1, 0x64, 0xc2, 0x9e, 0x21, 0xed, 0xc9, 0x3b, 0x0e, 0x8c, 0xc7, 0x7b,
+	0x12, 0xc5, 0xfd, 0x08, 0xce, 0xdc, 0x0d, 0x02, 0xe9, 0x80, 0x0f, 0xa2, 0x00, 0xeb, 0x5e, 0x7e,
+	0xd4, 0xc5, 0xba, 0xeb, 0x40, 0x73, 0x70, 0xb9, 0xa8, 0x2e, 0xdc, 0x01, 0xc7, 0xc3, 0xbd, 0x78,
+	0x1f, 0x7f, 0x69, 0xea, 0xe7, 0x60, 0xb9, 0x90, 0x82, 0x78, 0xc0, 0x32, 0x2c, 0x6d, 0x61, 0x62,
+	0xcc, 0x61, 0x59, 0x3d, 0x76, 0x6f, 0x80, 0x53, 0x34, 0x39, 0xbc, 0x9c, 0xeb, 0xfe, 0x1e, 0x2f,
+	0x08, 0xdd, 0x0f, 0x53, 0x12, 0x27, 0x87, 0x27, 0xe0, 0x93, 0x5e, 0x61, 0xb2, 0xdb, 0x56, 0x75,
+	0xd7, 0x55, 0xf6, 0xaa, 0x14, 0xc0, 0x7a, 0x46, 0xce, 0xc0, 0x24, 0x89, 0xf5, 0x9e, 0x92, 0x09,
+	0x12, 0xb3, 0x09, 0x07, 0xaa, 0x61, 0x44, 0x70, 0xb2, 0xef, 0x77, 0x65, 0xd3, 0x85, 0x1c, 0xbb,
+	0x1f, 0x02, 0xd2, 0x59, 0x11, 0x5c, 0xbf, 0x31, 0xea, 0x6a, 0x27, 0xbb, 0x91, 0xd9, 0x02, 0xf4,
+	0x14, 0x13, 0xd5, 0x73, 0x25, 0x04, 0x79, 0xfb, 0x88, 0xde, 0x2c, 0xb5, 0x43, 0x14, 0x9a, 0x7b,
+	0x07, 0xe6, 0x0c, 0x42, 0xea, 0x8a, 0xe7, 0xb8, 0x5d, 0x5e, 0xee, 0x65, 0x56, 0xda, 0x97, 0x13,
+	0xe9, 0xa8, 0xf2, 0xd4, 0x4f, 0xf8, 0x5d, 0x87, 0x86, 0x2b, 0x1e, 0xf7, 0x6d, 0xa8, 0x49, 0x7a,
+	0xe6, 0x8b, 0x68, 0x11, 0xb6, 0x62, 0x42, 0x04, 0xa9, 0x6c, 0xa9, 0xf3, 0x31, 0xbd, 0xfa, 0xd1,
+	0x27, 0x0b, 0x02, 0xd0, 0x05, 0x33, 0x00, 0xe5, 0xe4, 0xd2, 0x82, 0xcf, 0x5b, 0xb0, 0xc8, 0x8b,
+	0x69, 0xc7, 0x92, 0x6d, 0x09, 0xce, 0x0c, 0x60, 0x0b, 0x27, 0xfe, 0xb1, 0x05, 0xcb, 0xfc, 0xc2,
+	0xd1, 0xe8, 0x09, 0x4a, 0x8f, 0x75, 0x3d, 0x7e, 0x01, 0x54, 0xeb, 0x50, 0x4b, 0x4b, 0xd1, 0xa6,
+	0x25, 0x90, 0x5e, 0x61, 0xa0, 0xf7, 0x61, 0x2a, 0xeb, 0x8c, 0xe3, 0xed, 0x2c, 0x23, 0xba, 0xe8,
+	0x74, 0x5c, 0xf7, 0x3e, 0x9c, 0x2d, 0xe6, 0x4d, 0x98, 0xe6, 0x92, 0xbc, 0xe2, 0xb6, 0x86, 0xf6,
+	0x36, 0x71, 0x04, 0xf7, 0x1e, 0xbb, 0x59, 0x15, 0xfd, 0x47, 0xda, 0x9b, 0x82, 0x68, 0x59, 0x32,
+	0xde, 0x14, 0x04, 0x56, 0xf6, 0xa6, 0x20, 0x90, 0xdc, 0x5b, 0xcc, 0xb1, 0x15, 0x11, 0xc1, 0xc4,
+	0xc5, 0x91, 0x54, 0xb2, 0xd5, 0x17, 0xd9, 0x9e, 0x12, 0x60, 0xa5, 0x5f, 0x1b, 0xca, 0x61, 0x20,
+	0xad, 0x45, 0x7f, 0xba, 0x7f, 0xc2, 0xef, 0xa3, 0x32, 0x44, 0x55, 0x0e, 0xa9, 0x0a, 0x52, 0xe6,
+	0x49, 0x59, 0x80, 0x2b, 0x1f, 0x2e, 0x9c, 0x50, 0xad, 0x73, 0x1e, 0x40, 0xdd, 0x98, 0x2a, 0x70,
+	0x41, 0xd7, 0x74, 0x41, 0x53, 0x18, 0xcd, 0x03, 0x2f, 0xc3, 0x02, 0xf7, 0xa9, 0xa3, 0x25, 0x6a,
+	0xc2, 0x62, 0x1e, 0x55, 0x78, 0xdf, 0x4d, 0x76, 0xa1, 0xb7, 0x81, 0xfd, 0xe0, 0x57, 0x30, 0x21,
+	0x38, 0x51, 0x44, 0xcc, 0xfe, 0x32, 0x2b, 0xd7, 0x5f, 0xe6, 0x3e, 0x82, 0xc5, 0xfc, 0x3a, 0xa1,
+	0xa5, 0x77, 0x00, 0x02, 0xde, 0xb4, 0x16, 0xaa, 0xed, 0x3a, 0xaf, 0xcb, 0x20, 0x5b, 0xda, 0x3c,
+	0x0d, 0xcf, 0xbd, 0x4e, 0x23, 0xbd, 0x18, 0x17, 0x70, 0x33, 0x28, 0xd2, 0x2d, 0x38, 0x5b, 0xbc,
+	0x40, 0xb0, 0x71, 0x16, 0x6a, 0x62, 0x16, 0x07, 0x62, 0x5d, 0x06, 0x70, 0xaf, 0xb0, 0x3b, 0x23,
+	0xfe, 0x61, 0x8c, 0x78, 0x84, 0xd6, 0x9e, 0x6e, 0x19, 0xed, 0xe9, 0xee, 0x3b, 0x60, 0x67, 0xc8,
+	0x82, 0xfc, 0xca, 0xd0, 0x44, 0x43, 0x24, 0x18, 0xee, 0xdf, 0x5a, 0xd0, 0x78, 0xe8, 0xf7, 0xd3,
+	0x7b, 0x7e, 0x7b, 0x07, 0xd3, 0xce, 0x51, 0x9a, 0x27, 0x8d, 0xb3, 0xa6, 0x44, 0xfe, 0x31, 0x03,
+	0xdf, 0x2c, 0x0a, 0x85, 0x75, 0x83, 0xb0, 0x79, 0xca, 0x0a, 0x8e, 0x08, 0xd3, 0x1f, 0x3f, 0x4c,
+	0xe4, 0x90, 0x5a, 0x85, 0x26, 0x83, 0xad, 0xe7, 0x87, 0x04, 0x8b, 0x4f, 0x4f, 0xbc, 0x1a, 0x85,
+	0xac, 0x53, 0x00, 0xed, 0xc5, 0xa3, 0x69, 0x8b, 0x5c, 0xcc, 0x0f, 0x15, 0xe8, 0xf9, 0x07, 0x9b,
+	0x62, 0x3d, 0x82, 0xf1, 0x9d, 0x90, 0xa4, 0xa2, 0xb1, 0x9a, 0xfd, 0xa6, 0x89, 0x4c, 0x2f, 0x4c,
+	0x53, 0x9c, 0x8a, 0xae, 0x03, 0x31, 0xa2, 0x47, 0x3b, 0x3d, 0x40, 0x0d, 0x11, 0xe4, 0xe1, 0x7a,
+	0x1f, 0x96, 0x0a, 0xe6, 0x54, 0xf7, 0xc0, 0x44, 0x9b, 0x42, 0xa5, 0xf5, 0xe7, 0x4c, 0x41, 0x39,
+	0xb2, 0x40, 0x71, 0xef, 0xc2, 0xc2, 0x93, 0xbd, 0xa4, 0x83, 0xd5, 0xb4, 0x96, 0xca, 0xf1, 0xa6,
+	0x51, 0x6b, 0xa5, 0x3c, 0x44, 0x5b, 0x1c, 0xc1, 0xbd, 0x01, 0x8b, 0x79, 0x12, 0x82, 0x13, 0x9a,
+	0xa3, 0xd1, 0x19, 0xee, 0xc0, 0x65, 0x4f, 0x8c, 0xdc, 0x4f, 0x61, 0x81, 0xd5, 0x2e, 0x07, 0x1e,
+	0x7a, 0x5c, 0x0b, 0xdd, 0x30, 0x15, 0xcd, 0x73, 0xc8, 0x81, 0xdc, 0x5c, 0xd3, 0xbc, 0xbb, 0x06,
+	0x8b, 0xf9, 0x47, 0x0a, 0x26, 0xa9, 0xb5, 0xf7, 0xc3, 0x36, 0x51, 0x5c, 0xca, 0xa1, 0x5b, 0x87,
+	0xa9, 0x27, 0xf4, 0x2b, 0x21, 0xa1, 0xf4, 0xd7, 0x60, 0x9a, 0x0f, 0xb3, 0xbe, 0x14, 0x11, 0xf2,
+	0xaa, 0x5e, 0x29, 0xde, 0x5d, 0x7d, 0x0c, 0x33, 0xb9, 0xee, 0x22, 0x34, 0x09, 0xe5, 0xa7, 0x98,
+	0xd8, 0x63, 0x68, 0x0a, 0x26, 0x79, 0x04, 0x08, 0x6c, 0x8b, 0x0e, 0x36, 0xd9, 0xc7, 0x3d, 0x81,
+	0x5d, 0x62, 0x33, 0x49, 0xdc, 0xbf, 0xdb, 0xed, 0xda, 0x65, 0x34, 0x0d, 0xd5, 0xcd, 0x28, 0x09,
+	0xdb, 0x3b, 0x38, 0xb0, 0xc7, 0x57, 0xef, 0x00, 0x92, 0xb1, 0x3c, 0x3b, 0x20, 0x10, 0xc0, 0xc4,
+	0x03, 0xf6, 0xd5, 0x83, 0x3d, 0x86, 0x6a, 0x50, 0xd9, 0xa4, 0x29, 0x8b, 0x6d, 0xa1, 0x2a, 0x8c,
+	0x6f, 0x1e, 0x84, 0xc4, 0x2e, 0x51, 0x20, 0x6b, 0x97, 0xb6, 0xcb, 0xab, 0x3f, 0xb2, 0xc0, 0xce,
+	0xb7, 0xdd, 0xa2, 0x59, 0xf9, 0xdd, 0x8d, 0xe8, 0x01, 0xb2, 0xc7, 0x10, 0x82, 0x86, 0xf8, 0x26,
+	0x40, 0xc2, 0x2c, 0xb4, 0x00, 0xb3, 0xd9, 0xd3, 0xc3, 0x4e, 0x07, 0x73, 0x7e, 0xd5, 0x6a, 0x29,
+	0x4f, 0x39, 0x03, 0x49, 0xa9, 0xc6, 0x29, 0x41, 0x01, 0x92, 0xe2, 0x54, 0x56, 0x7f, 0x1d, 0xec,
+	0x7c, 0xbf, 0x27, 0x13, 0xe0, 0xd3, 0x3d, 0xbf, 0x6b, 0x8f, 0x51, 0xd9, 0x1f, 0xc5, 0x84, 0x8f,
+	0x2c, 0x34, 0x01, 0xa5, 0x07, 0x11, 0x17, 0xe6, 0x51, 0x4c, 0x1e, 0x44, 0x76, 0x99, 0x0a, 0xbe,
+	0x79, 0x10, 0xa6, 0x24, 0xb5, 0xc7, 0x51, 0x1d, 0x6a, 0x14, 0x99, 0x0f, 0x2b, 0xab, 0xeb, 0x00,
+	0xd9, 0x57, 0x49, 0x5c, 0xa5, 0xe1, 0x7e, 0x18, 0x75, 0xb8, 0xe6, 0x3f, 0xf1, 0xbb, 0xf4, 0x9b,
+	0x26, 0xdb, 0xa2, 0xcb, 0xd6, 0xc3, 0xf6, 0x61, 0x9b, 0x7e, 0x9d, 0xc1, 0x75, 0x2f, 0x14, 0x6b,
+	0x97, 0x57, 0x5b, 0x50, 0x37, 0x5c, 0x0d, 0xcd, 0xc1, 0x4c, 0xf6, 0xf9, 0x08, 0x03, 0xdb, 0x63,
+	0xc8, 0x86, 0x69, 0xf1, 0x21, 0x06, 0x87, 0x58, 0x54, 0x7a, 0xf9, 0x29, 0x17, 0x07, 0x95, 0xd0,
+	0x3c, 0xd8, 0xf7, 0xe2, 0x38, 0x09, 0xc2, 0xc8, 0x27, 0x58, 0x20, 0x96, 0xd7, 0x7e, 0x76, 0x0e,
+	0x2a, 0x5b, 0x38, 0xde, 0x58, 0x47, 0x57, 0x61, 0x9c, 0x7a, 0x12, 0xb2, 0x79, 0xd8, 0xca, 0x7c,
+	0xcc, 0x99, 0xd5, 0x20, 0xe2, 0x88, 0x18, 0x43, 0xab, 0xcc, 0x8b, 0x10, 0xff, 0xa2, 0x25, 0xeb,
+	0x34, 0x72, 0xec, 0x0c, 0xa0, 0x70, 0xef, 0x40, 0x4d, 0x75, 0x50, 0xa1, 0x05, 0x89, 0x60, 0xb4,
+	0x76, 0x39, 0x8b, 0x79, 0xb0, 0x5c, 0x7d, 0xc9, 0xba, 0x61, 0xa1, 0xf7, 0xa1, 0x2a, 0x1b, 0x92,
+	0x10, 0x3f, 0x38, 0x72, 0x1d, 0x4e, 0xce, 0x42, 0x0e, 0xaa, 0x33, 0xba, 0xa5, 0x18, 0xdd, 0xca,
+	0x33, 0xba, 0x65, 0xe0, 0xbe, 0x0f, 0x55, 0x79, 0xb1, 0x2e, 0x1e, 0x93, 0xeb, 0x22, 0x70, 0x16,
+	0x72, 0x50, 0xb5, 0xf4, 0x16, 0xd4, 0xd4, 0x7d, 0x30, 0x5a, 0xc8, 0xdf, 0x0f, 0xeb, 0x32, 0x0e,
+	0x5c, 0x1b, 0xbb, 0x63, 0xe8, 0x26, 0x4c, 0x8a, 0x16, 0x21, 0x34, 0x27, 0x91, 0xb4, 0xb6, 0x17,
+	0x67, 0xde, 0x04, 0xaa, 0x75, 0x9b, 0x30, 0xad, 0x77, 0xa6, 0xa0, 0xa6, 0xc1, 0x9e, 0x4e, 0x61,
+	0xa9, 0x60, 0x46, 0x91, 0xb9, 0x0f, 0x75, 0xc5, 0x15, 0xa3, 0xb3, 0x64, 0x72, 0xaa, 0x13, 0x72,
+	0x8a, 0xa6, 0x14, 0xa5, 0x6f, 0xc2, 0x04, 0xdf, 0x83, 0x88, 0x07, 0x4a, 0xe3, 0x06, 0xda, 0x99,
+	0x33, 0x60, 0x6a, 0xd1, 0xbb, 0x30, 0x21, 0x9c, 0x83, 0x2f, 0x32, 0x3d, 0x63, 0xce, 0x80, 0xc9,
+	0x45, 0x37, 0x2c, 0xb4, 0x01, 0x53, 0x5a, 0x27, 0x2f, 0x3a, 0x63, 0xe0, 0x69, 0x36, 0x6b, 0x0e,
+	0x4e, 0x68, 0x54, 0xb6, 0x60, 0x5a, 0x6f, 0x78, 0x45, 0x3a, 0xb6, 0x69, 0xbe, 0xa5, 0x82, 0x99,
+	0x22, 0x76, 0xf8, 0x77, 0xb7, 0x3a, 0x3b, 0xfa, 0x75, 0xa0, 0xd3, 0x1c, 0x9c, 0xd0, 0xa8, 0xdc,
+	0x82, 0x9a, 0xba, 0x8f, 0x96, 0x7b, 0x25, 0x77, 0x1b, 0xef, 0x2c, 0xe6, 0xc1, 0x4a, 0x93, 0x1f,
+	0xf3, 0x5a, 0x6e, 0x76, 0x13, 0x87, 0x9c, 0xc2, 0xeb, 0x39, 0x4e, 0x67, 0x79, 0xc4, 0xd5, 0x9d,
+	0x3b, 0x86, 0x1e, 0xf1, 0x9a, 0xaa, 0x76, 0xf3, 0x8a, 0x96, 0x8b, 0xef, 0x63, 0x39, 0xb9, 0xb3,
+	0xa3, 0x2e, 0x6b, 0xdd, 0x31, 0xb4, 0x0e, 0x53, 0xda, 0xf5, 0x93, 0x54, 0xd0, 0xc0, 0x85, 0x9c,
+	0xd3, 0x1c, 0x9c, 0x50, 0x34, 0x7e, 0x15, 0x6c, 0xc5, 0xaf, 0x24, 0x74, 0x76, 0x48, 0xb5, 0x9d,
+	0x53, 0x3b, 0x37, 0xb2, 0x16, 0xef, 0x8e, 0xa1, 0x67, 0x30, 0x9b, 0xf1, 0x2c, 0x69, 0x9e, 0x1b,
+	0x76, 0xf1, 0xc2, 0x89, 0xbe, 0x36, 0xfa, 0x5e, 0x86, 0xef, 0x68, 0x51, 0x69, 0x16, 0x3b, 0xda,
+	0xac, 0xb1, 0x3b, 0xf3, 0x26, 0x50, 0xdf, 0xd1, 0x7a, 0x95, 0x0d, 0x35, 0x0b, 0x0a, 0x6f, 0x86,
+	0x3b, 0x16, 0x94, 0xe4, 0xf8, 0x8e, 0x36, 0x6a, 0xa9, 0x68, 0xa9, 0xa8, 0xbe, 0xaa, 0xef, 0xe8,
+	0xc2, 0xd2, 0x2b, 0xdb, 0xd1, 0x34, 0xb0, 0x89, 0xfd, 0x39, 0x10, 0x45, 0xf5, 0x12, 0x15, 0x2f,
+	0x23, 0x31, 0x2f, 0xfe, 0x88, 0x75, 0x54, 0x32, 0xbe, 0xc4, 0xca, 0xe2, 0x70, 0x3a, 0x64, 0xf9,
+	0x6d, 0x9e, 0x8a, 0x33, 0x6e, 0x8c, 0x63, 0x63, 0x20, 0xa4, 0x0e, 0x27, 0xa0, 0xb6, 0x87, 0x79,
+	0xee, 0xe4, 0xf7, 0xd2, 0x10, 0x02, 0x0f, 0x8c, 0xc2, 0x68, 0x46, 0x65, 0xd4, 0x76, 0x1a, 0x42,
+	0xea, 0x63, 0xb3, 0x06, 0x9e, 0xd1, 0x1a, 0xb9, 0x99, 0x86, 0x10, 0xbb, 0x27, 0x9c, 0x95, 0xfb,
+	0x9b, 0x20, 0x34, 0x74, 0x27, 0x0d, 0x21, 0xf2, 0x50, 0xbb, 0xdd, 0x37, 0x29, 0x8d, 0xde, 0x4a,
+	0x43, 0xc8, 0x3d, 0xd6, 0x1b, 0x3a, 0x4c, 0x7a, 0x47, 0x6c, 0xa3, 0x21, 0x04, 0x3f, 0xe4, 0xce,
+	0xbb, 0x1e, 0x4b, 0xe3, 0x17, 0xee, 0xa0, 0x21, 0x8b, 0x37, 0x01, 0x29, 0xfe, 0x33, 0x0a, 0xc3,
+	0xb7, 0xd1, 0x10, 0x32, 0x5b, 0x30, 0x97, 0xb1, 0x9d, 0xd1, 0x19, 0xb1, 0x8d, 0x86, 0x10, 0xba,
+	0x09, 0x93, 0xa2, 0xcc, 0x2a, 0xc4, 0x30, 0xcb, 0xd3, 0xce, 0xbc, 0x09, 0xd4, 0x23, 0x5d, 0xbe,
+	0x8a, 0x2a, 0xcc, 0x33, 0xa4, 0x36, 0xeb, 0x9c, 0x1b, 0x32, 0xab, 0x48, 0x7e, 0x0f, 0xe6, 0x0a,
+	0x4a, 0xa7, 0xe8, 0x3c, 0x5b, 0x37, 0xbc, 0x2c, 0xeb, 0xac, 0x0c, 0x47, 0x50, 0xb4, 0x3f, 0x61,
+	0x85, 0x14, 0x63, 0x16, 0xa7, 0xe8, 0x35, 0xb9, 0x6b, 0x8b, 0x0b, 0xb2, 0xce, 0xf9, 0xa1, 0xf3,
+	0x8a, 0xf0, 0x6d, 0x80, 0xac, 0xea, 0x89, 0x54, 0x0a, 0x65, 0x56, 0x64, 0x9d, 0x33, 0x03, 0x70,
+	0xe3, 0xd8, 0xc9, 0x8a, 0x82, 0x72, 0xb3, 0x0c, 0xd4, 0x42, 0x9d, 0xe6, 0xe0, 0x44, 0x2e, 0xcf,
+	0x92, 0x13, 0x5a, 0x9e, 0x95, 0xaf, 0xf4, 0x39, 0x4b, 0x05, 0x33, 0xfa, 0x89, 0x9a, 0x2b, 0xf9,
+	0x89, 0x20, 0x50, 0x5c, 0x36, 0x74, 0xce, 0x16, 0x4f, 0x2a, 0x7a, 0x2d, 0xf9, 0xb1, 0x8e, 0x59,
+	0x8a, 0x43, 0x2b, 0x5a, 0x8a, 0x51, 0x58, 0x41, 0x74, 0x5e, 0x1f, 0x81, 0xa1, 0x65, 0x23, 0xb7,
+	0xd9, 0xe7, 0x26, 0xf2, 0x23, 0x52, 0x95, 0xa3, 0x9b, 0x25, 0x3b, 0xe7, 0xcc, 0x00, 0x5c, 0x57,
+	0xbe, 0x56, 0x0a, 0x43, 0x67, 0x06, 0x8b, 0x63, 0xba, 0xf2, 0x0b, 0xaa, 0x66, 0x3c, 0xa9, 0x31,
+	0x2b, 0x55, 0x22, 0x0a, 0x17, 0x56, 0xba, 0x9c, 0xe5, 0xc2, 0x39, 0x9d, 0x98, 0x59, 0xa4, 0x42,
+	0x2a, 0xa1, 0x1d, 0xac, 0x31, 0x39, 0xcb, 0x85, 0x73, 0x8a, 0xd8, 0xf7, 0x61, 0xbe, 0xa8, 0xe0,
+	0x84, 0xe4, 0x86, 0x19, 0x5a, 0xbc, 0x72, 0x5e, 0x1f, 0x81, 0x91, 0x7b, 0x1d, 0xe1, 0xff, 0xf3,
+	0x45, 0x9d, 0x9f, 0x7a, 0x81, 0xca, 0x59, 0xc8, 0x41, 0xf5, 0xa4, 0x66, 0xa0, 0x18, 0x83, 0x54,
+	0xd7, 0x76, 0x61, 0x01, 0xc7, 0x79, 0x6d, 0xd8, 0xb4, 0xae, 0x3c, 0xb3, 0xaa, 0x22, 0x94, 0x57,
+	0x58, 0xad, 0x71, 0x96, 0x0b, 0xe7, 0x74, 0x62, 0x66, 0xf5, 0x43, 0x10, 0x2b, 0xac, 0xc2, 0x38,
+	0xcb, 0x85, 0x73, 0x92, 0xd8, 0x7a, 0xe5, 0x7b, 0xf4, 0x5f, 0xeb, 0x3c, 0x9f, 0x60, 0xff, 0x29,
+	0xe7, 0x9b, 0xff, 0x3f, 0x00, 0x75, 0x13, 0xd3, 0x66, 0x73, 0x47, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GeoDBClient is the client API for GeoDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GeoDBClient interface {
+	//Ping - input: empty, output: returns ok if server is healthy.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	//Set - input: an object and a precondition(optional) output: an object detail. Object details are enhanced when the google maps integration is active.
+	//Writes that don't meet the precondition are rejected with a FailedPrecondition error
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	//SetStream - input: a stream of objects, output: a stream of acknowledgements carrying the status and sequence of every object in the order they were received.
+	//Objects are written in batched transactions so a gateway can hold one long-lived connection for high-frequency updates
+	SetStream(ctx context.Context, opts ...grpc.CallOption) (GeoDB_SetStreamClient, error)
+	//BatchSet - input: an array of objects, output: an array of object details in the same order. The objects are written in one transaction,
+	//either every object is set or none of them are
+	BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error)
+	//Get - input: an array of object keys, output: returns an array of current object details
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
+	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
+	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
+	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
+	//GetKeys -  input: none, output: returns all keys in database
+	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
+	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
+	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
+	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
+	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
+	//Delete -  input: an array of object key strings to delete, output: none
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	//Stream -  input: a clientID(optional), an array of object keys(optional) and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
+	//StreamRegex -  input: a clientID(optional) a regex string and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
+	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
+	//StreamPrefix -  input: a clientID(optional) a prefix string and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
+	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
+	//StreamBound -  input: a clientID(optional) a circle or polygon area and a metadata filter(optional),
+	//output: a stream of object details for realtime object geolocation updates inside the area. An object that moves out of the area is sent once more with left set
+	StreamBound(ctx context.Context, in *StreamBoundRequest, opts ...grpc.CallOption) (GeoDB_StreamBoundClient, error)
+	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
+	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
+	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
+	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
+	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
+	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
+	//ScanPolygon -  input: a polygon, string-array of unique object ids(optional), output: returns an array of current object details that are within the polygon
+	ScanPolygon(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (*ScanPolygonResponse, error)
+	//ScanRegexPolygon -  input: a polygon, a regex string, output: returns an array of current object details that have keys that match the regex and are within the polygon
+	ScanRegexPolygon(ctx context.Context, in *ScanRegexPolygonRequest, opts ...grpc.CallOption) (*ScanRegexPolygonResponse, error)
+	//ScanPrefixPolygon -  input: a polygon, a prefix string, output: returns an array of current object details that have keys that match the prefix and are within the polygon
+	ScanPrefixPolygon(ctx context.Context, in *ScanPrefixPolygonRequest, opts ...grpc.CallOption) (*ScanPrefixPolygonResponse, error)
+	//ScanBox -  input: a south-west/north-east bounding box, string-array of unique object ids(optional), output: returns an array of current object details that are within the box
+	ScanBox(ctx context.Context, in *ScanBoxRequest, opts ...grpc.CallOption) (*ScanBoxResponse, error)
+	//ScanRegexBox -  input: a south-west/north-east bounding box, a regex string, output: returns an array of current object details that have keys that match the regex and are within the box
+	ScanRegexBox(ctx context.Context, in *ScanRegexBoxRequest, opts ...grpc.CallOption) (*ScanRegexBoxResponse, error)
+	//ScanPrefixBox -  input: a south-west/north-east bounding box, a prefix string, output: returns an array of current object details that have keys that match the prefix and are within the box
+	ScanPrefixBox(ctx context.Context, in *ScanPrefixBoxRequest, opts ...grpc.CallOption) (*ScanPrefixBoxResponse, error)
+	//The ...Stream variants of Get, GetRegex, GetPrefix and the Scan methods take the same request but send every object detail as soon as it is read.
+	//Every result carries a page_token that resumes the listing after it, the page_size of the request is ignored
+	GetStream(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (GeoDB_GetStreamClient, error)
+	GetRegexStream(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (GeoDB_GetRegexStreamClient, error)
+	GetPrefixStream(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (GeoDB_GetPrefixStreamClient, error)
+	ScanBoundStream(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanBoundStreamClient, error)
+	ScanRegexBoundStream(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoundStreamClient, error)
+	ScanPrefixBoundStream(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoundStreamClient, error)
+	ScanPolygonStream(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPolygonStreamClient, error)
+	ScanRegexPolygonStream(ctx context.Context, in *ScanRegexPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexPolygonStreamClient, error)
+	ScanPrefixPolygonStream(ctx context.Context, in *ScanPrefixPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixPolygonStreamClient, error)
+	ScanBoxStream(ctx context.Context, in *ScanBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanBoxStreamClient, error)
+	ScanRegexBoxStream(ctx context.Context, in *ScanRegexBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoxStreamClient, error)
+	ScanPrefixBoxStream(ctx context.Context, in *ScanPrefixBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoxStreamClient, error)
+	//Nearest -  input: a point, a limit, a max distance(optional) and a prefix or regex(optional),
+	//output: returns the closest object details to the point ordered by their distance from it
+	Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*NearestResponse, error)
+	//AddMetadataIndex - input: a metadata key, output: none. Builds an index on the metadata key that Get/Scan requests with a metadata filter on the key will use
+	AddMetadataIndex(ctx context.Context, in *AddMetadataIndexRequest, opts ...grpc.CallOption) (*AddMetadataIndexResponse, error)
+	//RemoveMetadataIndex - input: a metadata key, output: none. Removes the index on the metadata key
+	RemoveMetadataIndex(ctx context.Context, in *RemoveMetadataIndexRequest, opts ...grpc.CallOption) (*RemoveMetadataIndexResponse, error)
+	//GetMetadataIndexes - input: none, output: returns all indexed metadata keys
+	GetMetadataIndexes(ctx context.Context, in *GetMetadataIndexesRequest, opts ...grpc.CallOption) (*GetMetadataIndexesResponse, error)
+	//GetHistory - input: an object key and a time range, output: returns the objects positions over the time range(oldest first), optionally downsampled
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	//SetGeofence - input: a geofence, output: the stored geofence. Every object update is evaluated against stored geofences
+	SetGeofence(ctx context.Context, in *SetGeofenceRequest, opts ...grpc.CallOption) (*SetGeofenceResponse, error)
+	//GetGeofences - input: an array of geofence keys(optional), output: returns the geofences with the given keys or all geofences if no keys are present
+	GetGeofences(ctx context.Context, in *GetGeofencesRequest, opts ...grpc.CallOption) (*GetGeofencesResponse, error)
+	//DeleteGeofences - input: an array of geofence keys to delete, output: none
+	DeleteGeofences(ctx context.Context, in *DeleteGeofencesRequest, opts ...grpc.CallOption) (*DeleteGeofencesResponse, error)
+	//StreamGeofenceEvents - input: a clientID(optional), an array of geofence keys(optional) and an array of transitions(optional),
+	//output: a stream of realtime geofence events(enter, exit, inside, dwell)
+	StreamGeofenceEvents(ctx context.Context, in *StreamGeofenceEventsRequest, opts ...grpc.CallOption) (GeoDB_StreamGeofenceEventsClient, error)
+	//SetWebhook - input: a webhook subscription, output: the stored webhook. Matching events are POSTed to the webhooks url as json
+	SetWebhook(ctx context.Context, in *SetWebhookRequest, opts ...grpc.CallOption) (*SetWebhookResponse, error)
+	//GetWebhooks - input: an array of webhook ids(optional), output: returns the webhooks with the given ids or all webhooks if no ids are present(secrets are redacted)
+	GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error)
+	//DeleteWebhooks - input: an array of webhook ids to delete, output: none
+	DeleteWebhooks(ctx context.Context, in *DeleteWebhooksRequest, opts ...grpc.CallOption) (*DeleteWebhooksResponse, error)
+	//GetDeadLetters - input: a webhook id(optional), output: returns the deliveries that failed after every retry
+	GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*GetDeadLettersResponse, error)
+	//RedeliverDeadLetters - input: an array of dead letter ids, output: the ids that were delivered. Delivered dead letters are removed
+	RedeliverDeadLetters(ctx context.Context, in *RedeliverDeadLettersRequest, opts ...grpc.CallOption) (*RedeliverDeadLettersResponse, error)
+	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
+	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+	//GetMapsCacheStats - input: none, output: the number of entries, size, bound and hit/miss counts of every maps cache - maps integration is required.
+	GetMapsCacheStats(ctx context.Context, in *GetMapsCacheStatsRequest, opts ...grpc.CallOption) (*GetMapsCacheStatsResponse, error)
+	//PurgeMapsCache - input: an array of maps cache types(optional), output: the number of purged entries. Purges the given caches or every cache if no types are present
+	PurgeMapsCache(ctx context.Context, in *PurgeMapsCacheRequest, opts ...grpc.CallOption) (*PurgeMapsCacheResponse, error)
+	//BoundMapsCache - input: a maps cache type and its max number of entries(0 removes the bound), output: the number of evicted entries.
+	//The oldest entries are evicted once the cache exceeds its bound
+	BoundMapsCache(ctx context.Context, in *BoundMapsCacheRequest, opts ...grpc.CallOption) (*BoundMapsCacheResponse, error)
+}
+
+type geoDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGeoDBClient(cc *grpc.ClientConn) GeoDBClient {
+	return &geoDBClient{cc}
+}
+
+func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) SetStream(ctx context.Context, opts ...grpc.CallOption) (GeoDB_SetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/SetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBSetStreamClient{stream}
+	return x, nil
+}
+
+type GeoDB_SetStreamClient interface {
+	Send(*SetStreamRequest) error
+	Recv() (*SetStreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBSetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBSetStreamClient) Send(m *SetStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *geoDBSetStreamClient) Recv() (*SetStreamResponse, error) {
+	m := new(SetStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error) {
+	out := new(BatchSetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
+	out := new(GetRegexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
+	out := new(GetPrefixResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
+	out := new(GetKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
+	out := new(GetRegexKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
+	out := new(GetPrefixKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/StreamRegex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamRegexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamRegexClient interface {
+	Recv() (*StreamRegexResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamRegexClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
+	m := new(StreamRegexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[3], "/api.GeoDB/StreamPrefix", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamPrefixClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamPrefixClient interface {
+	Recv() (*StreamPrefixResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamPrefixClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
+	m := new(StreamPrefixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) StreamBound(ctx context.Context, in *StreamBoundRequest, opts ...grpc.CallOption) (GeoDB_StreamBoundClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[4], "/api.GeoDB/StreamBound", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamBoundClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamBoundClient interface {
+	Recv() (*StreamBoundResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamBoundClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamBoundClient) Recv() (*StreamBoundResponse, error) {
+	m := new(StreamBoundResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
+	out := new(ScanBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
+	out := new(ScanRegexBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
+	out := new(ScanPrefixBoundResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPolygon(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (*ScanPolygonResponse, error) {
+	out := new(ScanPolygonResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanRegexPolygon(ctx context.Context, in *ScanRegexPolygonRequest, opts ...grpc.CallOption) (*ScanRegexPolygonResponse, error) {
+	out := new(ScanRegexPolygonResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexPolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPrefixPolygon(ctx context.Context, in *ScanPrefixPolygonRequest, opts ...grpc.CallOption) (*ScanPrefixPolygonResponse, error) {
+	out := new(ScanPrefixPolygonResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixPolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanBox(ctx context.Context, in *ScanBoxRequest, opts ...grpc.CallOption) (*ScanBoxResponse, error) {
+	out := new(ScanBoxResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanRegexBox(ctx context.Context, in *ScanRegexBoxRequest, opts ...grpc.CallOption) (*ScanRegexBoxResponse, error) {
+	out := new(ScanRegexBoxResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) ScanPrefixBox(ctx context.Context, in *ScanPrefixBoxRequest, opts ...grpc.CallOption) (*ScanPrefixBoxResponse, error) {
+	out := new(ScanPrefixBoxResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetStream(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (GeoDB_GetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[5], "/api.GeoDB/GetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBGetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetRegexStream(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (GeoDB_GetRegexStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[6], "/api.GeoDB/GetRegexStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetRegexStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetRegexStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBGetRegexStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetRegexStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) GetPrefixStream(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (GeoDB_GetPrefixStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[7], "/api.GeoDB/GetPrefixStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBGetPrefixStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_GetPrefixStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBGetPrefixStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBGetPrefixStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanBoundStream(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[8], "/api.GeoDB/ScanBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanBoundStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanBoundStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanRegexBoundStream(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[9], "/api.GeoDB/ScanRegexBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanRegexBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanRegexBoundStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanRegexBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanRegexBoundStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPrefixBoundStream(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoundStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[10], "/api.GeoDB/ScanPrefixBoundStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPrefixBoundStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPrefixBoundStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPrefixBoundStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPrefixBoundStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPolygonStream(ctx context.Context, in *ScanPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPolygonStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[11], "/api.GeoDB/ScanPolygonStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPolygonStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPolygonStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPolygonStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPolygonStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanRegexPolygonStream(ctx context.Context, in *ScanRegexPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexPolygonStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[12], "/api.GeoDB/ScanRegexPolygonStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanRegexPolygonStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanRegexPolygonStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanRegexPolygonStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanRegexPolygonStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPrefixPolygonStream(ctx context.Context, in *ScanPrefixPolygonRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixPolygonStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[13], "/api.GeoDB/ScanPrefixPolygonStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPrefixPolygonStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPrefixPolygonStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPrefixPolygonStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPrefixPolygonStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanBoxStream(ctx context.Context, in *ScanBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanBoxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[14], "/api.GeoDB/ScanBoxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanBoxStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanBoxStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanBoxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanBoxStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanRegexBoxStream(ctx context.Context, in *ScanRegexBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanRegexBoxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[15], "/api.GeoDB/ScanRegexBoxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanRegexBoxStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanRegexBoxStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanRegexBoxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanRegexBoxStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) ScanPrefixBoxStream(ctx context.Context, in *ScanPrefixBoxRequest, opts ...grpc.CallOption) (GeoDB_ScanPrefixBoxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[16], "/api.GeoDB/ScanPrefixBoxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBScanPrefixBoxStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_ScanPrefixBoxStreamClient interface {
+	Recv() (*ObjectResult, error)
+	grpc.ClientStream
+}
+
+type geoDBScanPrefixBoxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBScanPrefixBoxStreamClient) Recv() (*ObjectResult, error) {
+	m := new(ObjectResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) Nearest(ctx context.Context, in *NearestRequest, opts ...grpc.CallOption) (*NearestResponse, error) {
+	out := new(NearestResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/Nearest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) AddMetadataIndex(ctx context.Context, in *AddMetadataIndexRequest, opts ...grpc.CallOption) (*AddMetadataIndexResponse, error) {
+	out := new(AddMetadataIndexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/AddMetadataIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) RemoveMetadataIndex(ctx context.Context, in *RemoveMetadataIndexRequest, opts ...grpc.CallOption) (*RemoveMetadataIndexResponse, error) {
+	out := new(RemoveMetadataIndexResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/RemoveMetadataIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetMetadataIndexes(ctx context.Context, in *GetMetadataIndexesRequest, opts ...grpc.CallOption) (*GetMetadataIndexesResponse, error) {
+	out := new(GetMetadataIndexesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetMetadataIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) SetGeofence(ctx context.Context, in *SetGeofenceRequest, opts ...grpc.CallOption) (*SetGeofenceResponse, error) {
+	out := new(SetGeofenceResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/SetGeofence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetGeofences(ctx context.Context, in *GetGeofencesRequest, opts ...grpc.CallOption) (*GetGeofencesResponse, error) {
+	out := new(GetGeofencesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetGeofences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteGeofences(ctx context.Context, in *DeleteGeofencesRequest, opts ...grpc.CallOption) (*DeleteGeofencesResponse, error) {
+	out := new(DeleteGeofencesResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteGeofences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) StreamGeofenceEvents(ctx context.Context, in *StreamGeofenceEventsRequest, opts ...grpc.CallOption) (GeoDB_StreamGeofenceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[17], "/api.GeoDB/StreamGeofenceEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &geoDBStreamGeofenceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GeoDB_StreamGeofenceEventsClient interface {
+	Recv() (*StreamGeofenceEventsResponse, error)
+	grpc.ClientStream
+}
+
+type geoDBStreamGeofenceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *geoDBStreamGeofenceEventsClient) Recv() (*StreamGeofenceEventsResponse, error) {
+	m := new(StreamGeofenceEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *geoDBClient) SetWebhook(ctx context.Context, in *SetWebhookRequest, opts ...grpc.CallOption) (*SetWebhookResponse, error) {
+	out := new(SetWebhookResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/SetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error) {
+	out := new(GetWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) DeleteWebhooks(ctx context.Context, in *DeleteWebhooksRequest, opts ...grpc.CallOption) (*DeleteWebhooksResponse, error) {
+	out := new(DeleteWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/DeleteWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*GetDeadLettersResponse, error) {
+	out := new(GetDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) RedeliverDeadLetters(ctx context.Context, in *RedeliverDeadLettersRequest, opts ...grpc.CallOption) (*RedeliverDeadLettersResponse, error) {
+	out := new(RedeliverDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/RedeliverDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
+	out := new(GetPointResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) GetMapsCacheStats(ctx context.Context, in *GetMapsCacheStatsRequest, opts ...grpc.CallOption) (*GetMapsCacheStatsResponse, error) {
+	out := new(GetMapsCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/GetMapsCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) PurgeMapsCache(ctx context.Context, in *PurgeMapsCacheRequest, opts ...grpc.CallOption) (*PurgeMapsCacheResponse, error) {
+	out := new(PurgeMapsCacheResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/PurgeMapsCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoDBClient) BoundMapsCache(ctx context.Context, in *BoundMapsCacheRequest, opts ...grpc.CallOption) (*BoundMapsCacheResponse, error) {
+	out := new(BoundMapsCacheResponse)
+	err := c.cc.Invoke(ctx, "/api.GeoDB/BoundMapsCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeoDBServer is the server API for GeoDB service.
+type GeoDBServer interface {
+	//Ping - input: empty, output: returns ok if server is healthy.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	//Set - input: an object and a precondition(optional) output: an object detail. Object details are enhanced when the google maps integration is active.
+	//Writes that don't meet the precondition are rejected with a FailedPrecondition error
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	//SetStream - input: a stream of objects, output: a stream of acknowledgements carrying the status and sequence of every object in the order they were received.
+	//Objects are written in batched transactions so a gateway can hold one long-lived connection for high-frequency updates
+	SetStream(GeoDB_SetStreamServer) error
+	//BatchSet - input: an array of objects, output: an array of object details in the same order. The objects are written in one transaction,
+	//either every object is set or none of them are
+	BatchSet(context.Context, *BatchSetRequest) (*BatchSetResponse, error)
+	//Get - input: an array of object keys, output: returns an array of current object details
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
+	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
+	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
+	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
+	//GetKeys -  input: none, output: returns all keys in database
+	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
+	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
+	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
+	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
+	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
+	//Delete -  input: an array of object key strings to delete, output: none
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	//Stream -  input: a clientID(optional), an array of object keys(optional) and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates
+	Stream(*StreamRequest, GeoDB_StreamServer) error
+	//StreamRegex -  input: a clientID(optional) a regex string and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
+	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
+	//StreamPrefix -  input: a clientID(optional) a prefix string and a sequence to resume from(optional),
+	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
+	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
+	//StreamBound -  input: a clientID(optional) a circle or polygon area and a metadata filter(optional),
+	//output: a stream of object details for realtime object geolocation updates inside the area. An object that moves out of the area is sent once more with left set
+	StreamBound(*StreamBoundRequest, GeoDB_StreamBoundServer) error
+	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
+	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
+	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
+	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
+	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
+	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
+	//ScanPolygon -  input: a polygon, string-array of unique object ids(optional), output: returns an array of current object details that are within the polygon
+	ScanPolygon(context.Context, *ScanPolygonRequest) (*ScanPolygonResponse, error)
+	//ScanRegexPolygon -  input: a polygon, a regex string, output: returns an array of current object details that have keys that match the regex and are within the polygon
+	ScanRegexPolygon(context.Context, *ScanRegexPolygonRequest) (*ScanRegexPolygonResponse, error)
+	//ScanPrefixPolygon -  input: a polygon, a prefix string, output: returns an array of current object details that have keys that match the prefix and are within the polygon
+	ScanPrefixPolygon(context.Context, *ScanPrefixPolygonRequest) (*ScanPrefixPolygonResponse, error)
+	//ScanBox -  input: a south-west/north-east bounding box, string-array of unique object ids(optional), output: returns an array of current object details that are within the box
+	ScanBox(context.Context, *ScanBoxRequest) (*ScanBoxResponse, error)
+	//ScanRegexBox -  input: a south-west/north-east bounding box, a regex string, output: returns an array of current object details that have keys that match the regex and are within the box
+	ScanRegexBox(context.Context, *ScanRegexBoxRequest) (*ScanRegexBoxResponse, error)
+	//ScanPrefixBox -  input: a south-west/north-east bounding box, a prefix string, output: returns an array of current object details that have keys that match the prefix and are within the box
+	ScanPrefixBox(context.Context, *ScanPrefixBoxRequest) (*ScanPrefixBoxResponse, error)
+	//The ...Stream variants of Get, GetRegex, GetPrefix and the Scan methods take the same request but send every object detail as soon as it is read.
+	//Every result carries a page_token that resumes the listing after it, the page_size of the request is ignored
+	GetStream(*GetRequest, GeoDB_GetStreamServer) error
+	GetRegexStream(*GetRegexRequest, GeoDB_GetRegexStreamServer) error
+	GetPrefixStream(*GetPrefixRequest, GeoDB_GetPrefixStreamServer) error
+	ScanBoundStream(*ScanBoundRequest, GeoDB_ScanBoundStreamServer) error
+	ScanRegexBoundStream(*ScanRegexBoundRequest, GeoDB_ScanRegexBoundStreamServer) error
+	ScanPrefixBoundStream(*ScanPrefixBoundRequest, GeoDB_ScanPrefixBoundStreamServer) error
+	ScanPolygonStream(*ScanPolygonRequest, GeoDB_ScanPolygonStreamServer) error
+	ScanRegexPolygonStream(*ScanRegexPolygonRequest, GeoDB_ScanRegexPolygonStreamServer) error
+	ScanPrefixPolygonStream(*ScanPrefixPolygonRequest, GeoDB_ScanPrefixPolygonStreamServer) error
+	ScanBoxStream(*ScanBoxRequest, GeoDB_ScanBoxStreamServer) error
+	ScanRegexBoxStream(*ScanRegexBoxRequest, GeoDB_ScanRegexBoxStreamServer) error
+	ScanPrefixBoxStream(*ScanPrefixBoxRequest, GeoDB_ScanPrefixBoxStreamServer) error
+	//Nearest -  input: a point, a limit, a max distance(optional) and a prefix or regex(optional),
+	//output: returns the closest object details to the point ordered by their distance from it
+	Nearest(context.Context, *NearestRequest) (*NearestResponse, error)
+	//AddMetadataIndex - input: a metadata key, output: none. Builds an index on the metadata key that Get/Scan requests with a metadata filter on the key will use
+	AddMetadataIndex(context.Context, *AddMetadataIndexRequest) (*AddMetadataIndexResponse, error)
+	//RemoveMetadataIndex - input: a metadata key, output: none. Removes the index on the metadata key
+	RemoveMetadataIndex(context.Context, *RemoveMetadataIndexRequest) (*RemoveMetadataIndexResponse, error)
+	//GetMetadataIndexes - input: none, output: returns all indexed metadata keys
+	GetMetadataIndexes(context.Context, *GetMetadataIndexesRequest) (*GetMetadataIndexesResponse, error)
+	//GetHistory - input: an object key and a time range, output: returns the objects positions over the time range(oldest first), optionally downsampled
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	//SetGeofence - input: a geofence, output: the stored geofence. Every object update is evaluated against stored geofences
+	SetGeofence(context.Context, *SetGeofenceRequest) (*SetGeofenceResponse, error)
+	//GetGeofences - input: an array of geofence keys(optional), output: returns the geofences with the given keys or all geofences if no keys are present
+	GetGeofences(context.Context, *GetGeofencesRequest) (*GetGeofencesResponse, error)
+	//DeleteGeofences - input: an array of geofence keys to delete, output: none
+	DeleteGeofences(context.Context, *DeleteGeofencesRequest) (*DeleteGeofencesResponse, error)
+	//StreamGeofenceEvents - input: a clientID(optional), an array of geofence keys(optional) and an array of transitions(optional),
+	//output: a stream of realtime geofence events(enter, exit, inside, dwell)
+	StreamGeofenceEvents(*StreamGeofenceEventsRequest, GeoDB_StreamGeofenceEventsServer) error
+	//SetWebhook - input: a webhook subscription, output: the stored webhook. Matching events are POSTed to the webhooks url as json
+	SetWebhook(context.Context, *SetWebhookRequest) (*SetWebhookResponse, error)
+	//GetWebhooks - input: an array of webhook ids(optional), output: returns the webhooks with the given ids or all webhooks if no ids are present(secrets are redacted)
+	GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksResponse, error)
+	//DeleteWebhooks - input: an array of webhook ids to delete, output: none
+	DeleteWebhooks(context.Context, *DeleteWebhooksRequest) (*DeleteWebhooksResponse, error)
+	//GetDeadLetters - input: a webhook id(optional), output: returns the deliveries that failed after every retry
+	GetDeadLetters(context.Context, *GetDeadLettersRequest) (*GetDeadLettersResponse, error)
+	//RedeliverDeadLetters - input: an array of dead letter ids, output: the ids that were delivered. Delivered dead letters are removed
+	RedeliverDeadLetters(context.Context, *RedeliverDeadLettersRequest) (*RedeliverDeadLettersResponse, error)
+	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
+	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+	//GetMapsCacheStats - input: none, output: the number of entries, size, bound and hit/miss counts of every maps cache - maps integration is required.
+	GetMapsCacheStats(context.Context, *GetMapsCacheStatsRequest) (*GetMapsCacheStatsResponse, error)
+	//PurgeMapsCache - input: an array of maps cache types(optional), output: the number of purged entries. Purges the given caches or every cache if no types are present
+	PurgeMapsCache(context.Context, *PurgeMapsCacheRequest) (*PurgeMapsCacheResponse, error)
+	//BoundMapsCache - input: a maps cache type and its max number of entries(0 removes the bound), output: the number of evicted entries.
+	//The oldest entries are evicted once the cache exceeds its bound
+	BoundMapsCache(context.Context, *BoundMapsCacheRequest) (*BoundMapsCacheResponse, error)
+}
+
+// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
+type UnimplementedGeoDBServer struct {
+}
+
+func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedGeoDBServer) SetStream(srv GeoDB_SetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetStream not implemented")
+}
+func (*UnimplementedGeoDBServer) BatchSet(ctx context.Context, req *BatchSetRequest) (*BatchSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+}
+func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamBound(req *StreamBoundRequest, srv GeoDB_StreamBoundServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPolygon(ctx context.Context, req *ScanPolygonRequest) (*ScanPolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexPolygon(ctx context.Context, req *ScanRegexPolygonRequest) (*ScanRegexPolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixPolygon(ctx context.Context, req *ScanPrefixPolygonRequest) (*ScanPrefixPolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixPolygon not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBox(ctx context.Context, req *ScanBoxRequest) (*ScanBoxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBox not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBox(ctx context.Context, req *ScanRegexBoxRequest) (*ScanRegexBoxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBox not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBox(ctx context.Context, req *ScanPrefixBoxRequest) (*ScanPrefixBoxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBox not implemented")
+}
+func (*UnimplementedGeoDBServer) GetStream(req *GetRequest, srv GeoDB_GetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStream not implemented")
+}
+func (*UnimplementedGeoDBServer) GetRegexStream(req *GetRegexRequest, srv GeoDB_GetRegexStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRegexStream not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPrefixStream(req *GetPrefixRequest, srv GeoDB_GetPrefixStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPrefixStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBoundStream(req *ScanBoundRequest, srv GeoDB_ScanBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBoundStream(req *ScanRegexBoundRequest, srv GeoDB_ScanRegexBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanRegexBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBoundStream(req *ScanPrefixBoundRequest, srv GeoDB_ScanPrefixBoundStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPrefixBoundStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPolygonStream(req *ScanPolygonRequest, srv GeoDB_ScanPolygonStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPolygonStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexPolygonStream(req *ScanRegexPolygonRequest, srv GeoDB_ScanRegexPolygonStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanRegexPolygonStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixPolygonStream(req *ScanPrefixPolygonRequest, srv GeoDB_ScanPrefixPolygonStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPrefixPolygonStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanBoxStream(req *ScanBoxRequest, srv GeoDB_ScanBoxStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanBoxStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanRegexBoxStream(req *ScanRegexBoxRequest, srv GeoDB_ScanRegexBoxStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanRegexBoxStream not implemented")
+}
+func (*UnimplementedGeoDBServer) ScanPrefixBoxStream(req *ScanPrefixBoxRequest, srv GeoDB_ScanPrefixBoxStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanPrefixBoxStream not implemented")
+}
+func (*UnimplementedGeoDBServer) Nearest(ctx context.Context, req *NearestRequest) (*NearestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nearest not implemented")
+}
+func (*UnimplementedGeoDBServer) AddMetadataIndex(ctx context.Context, req *AddMetadataIndexRequest) (*AddMetadataIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataIndex not implemented")
+}
+func (*UnimplementedGeoDBServer) RemoveMetadataIndex(ctx context.Context, req *RemoveMetadataIndexRequest) (*RemoveMetadataIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMetadataIndex not implemented")
+}
+func (*UnimplementedGeoDBServer) GetMetadataIndexes(ctx context.Context, req *GetMetadataIndexesRequest) (*GetMetadataIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataIndexes not implemented")
+}
+func (*UnimplementedGeoDBServer) GetHistory(ctx context.Context, req *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (*UnimplementedGeoDBServer) SetGeofence(ctx context.Context, req *SetGeofenceRequest) (*SetGeofenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGeofence not implemented")
+}
+func (*UnimplementedGeoDBServer) GetGeofences(ctx context.Context, req *GetGeofencesRequest) (*GetGeofencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeofences not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteGeofences(ctx context.Context, req *DeleteGeofencesRequest) (*DeleteGeofencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeofences not implemented")
+}
+func (*UnimplementedGeoDBServer) StreamGeofenceEvents(req *StreamGeofenceEventsRequest, srv GeoDB_StreamGeofenceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGeofenceEvents not implemented")
+}
+func (*UnimplementedGeoDBServer) SetWebhook(ctx context.Context, req *SetWebhookRequest) (*SetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWebhook not implemented")
+}
+func (*UnimplementedGeoDBServer) GetWebhooks(ctx context.Context, req *GetWebhooksRequest) (*GetWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (*UnimplementedGeoDBServer) DeleteWebhooks(ctx context.Context, req *DeleteWebhooksRequest) (*DeleteWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhooks not implemented")
+}
+func (*UnimplementedGeoDBServer) GetDeadLetters(ctx context.Context, req *GetDeadLettersRequest) (*GetDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
+func (*UnimplementedGeoDBServer) RedeliverDeadLetters(ctx context.Context, req *RedeliverDeadLettersRequest) (*RedeliverDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverDeadLetters not implemented")
+}
+func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+}
+func (*UnimplementedGeoDBServer) GetMapsCacheStats(ctx context.Context, req *GetMapsCacheStatsRequest) (*GetMapsCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMapsCacheStats not implemented")
+}
+func (*UnimplementedGeoDBServer) PurgeMapsCache(ctx context.Context, req *PurgeMapsCacheRequest) (*PurgeMapsCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMapsCache not implemented")
+}
+func (*UnimplementedGeoDBServer) BoundMapsCache(ctx context.Context, req *BoundMapsCacheRequest) (*BoundMapsCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoundMapsCache not implemented")
+}
+
+func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
+	s.RegisterService(&_GeoDB_serviceDesc, srv)
+}
+
+func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_SetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoDBServer).SetStream(&geoDBSetStreamServer{stream})
+}
+
+type GeoDB_SetStreamServer interface {
+	Send(*SetStreamResponse) error
+	Recv() (*SetStreamRequest, error)
+	grpc.ServerStream
+}
+
+type geoDBSetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBSetStreamServer) Send(m *SetStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *geoDBSetStreamServer) Recv() (*SetStreamRequest, error) {
+	m := new(SetStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GeoDB_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).BatchSet(ctx, req.(*BatchSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegexKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetRegexKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetPrefixKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
+}
+
+type GeoDB_StreamServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRegexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
+}
 
-// GeoDBClient is the client API for GeoDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GeoDBClient interface {
-	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error)
-	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error)
-	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error)
-	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error)
-	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	//Stream -  input: a clientID(optional) and an array of object keys(optional),
-	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error)
-	//StreamRegex -  input: a clientID(optional) a regex string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error)
-	//StreamPrefix -  input: a clientID(optional) a prefix string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error)
-	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error)
-	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error)
-	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error)
-	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+type GeoDB_StreamRegexServer interface {
+	Send(*StreamRegexResponse) error
+	grpc.ServerStream
 }
 
-type geoDBClient struct {
-	cc *grpc.ClientConn
+type geoDBStreamRegexServer struct {
+	grpc.ServerStream
 }
 
-func NewGeoDBClient(cc *grpc.ClientConn) GeoDBClient {
-	return &geoDBClient{cc}
+func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *geoDBClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Ping", in, out, opts...)
-	if err != nil {
+func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPrefixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
+}
+
+type GeoDB_StreamPrefixServer interface {
+	Send(*StreamPrefixResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamPrefixServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_StreamBound_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).StreamBound(m, &geoDBStreamBoundServer{stream})
+}
+
+type GeoDB_StreamBoundServer interface {
+	Send(*StreamBoundResponse) error
+	grpc.ServerStream
+}
+
+type geoDBStreamBoundServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBStreamBoundServer) Send(m *StreamBoundResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Set", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegexBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanRegexBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Get", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPrefixBoundRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPrefixBound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetRegex(ctx context.Context, in *GetRegexRequest, opts ...grpc.CallOption) (*GetRegexResponse, error) {
-	out := new(GetRegexResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegex", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPolygonRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPolygon(ctx, req.(*ScanPolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetPrefix(ctx context.Context, in *GetPrefixRequest, opts ...grpc.CallOption) (*GetPrefixResponse, error) {
-	out := new(GetPrefixResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefix", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanRegexPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegexPolygonRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanRegexPolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanRegexPolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanRegexPolygon(ctx, req.(*ScanRegexPolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetKeys(ctx context.Context, in *GetKeysRequest, opts ...grpc.CallOption) (*GetKeysResponse, error) {
-	out := new(GetKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetKeys", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanPrefixPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPrefixPolygonRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPrefixPolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPrefixPolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPrefixPolygon(ctx, req.(*ScanPrefixPolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *geoDBClient) GetRegexKeys(ctx context.Context, in *GetRegexKeysRequest, opts ...grpc.CallOption) (*GetRegexKeysResponse, error) {
-	out := new(GetRegexKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetRegexKeys", in, out, opts...)
-	if err != nil {
+func _GeoDB_ScanBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBoxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanBox(ctx, req.(*ScanBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_ScanRegexBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegexBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanRegexBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanRegexBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanRegexBox(ctx, req.(*ScanRegexBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_ScanPrefixBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanPrefixBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).ScanPrefixBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/ScanPrefixBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).ScanPrefixBox(ctx, req.(*ScanPrefixBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoDB_GetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).GetStream(m, &geoDBGetStreamServer{stream})
+}
+
+type GeoDB_GetStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
+}
+
+type geoDBGetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBGetStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_GetRegexStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRegexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).GetRegexStream(m, &geoDBGetRegexStreamServer{stream})
+}
+
+type GeoDB_GetRegexStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
+}
+
+type geoDBGetRegexStreamServer struct {
+	grpc.ServerStream
 }
 
-func (c *geoDBClient) GetPrefixKeys(ctx context.Context, in *GetPrefixKeysRequest, opts ...grpc.CallOption) (*GetPrefixKeysResponse, error) {
-	out := new(GetPrefixKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPrefixKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *geoDBGetRegexStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *geoDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _GeoDB_GetPrefixStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPrefixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).GetPrefixStream(m, &geoDBGetPrefixStreamServer{stream})
 }
 
-func (c *geoDBClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GeoDB_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[0], "/api.GeoDB/Stream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GeoDB_GetPrefixStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
+type geoDBGetPrefixStreamServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamClient struct {
-	grpc.ClientStream
+func (x *geoDBGetPrefixStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func _GeoDB_ScanBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(GeoDBServer).ScanBoundStream(m, &geoDBScanBoundStreamServer{stream})
 }
 
-func (c *geoDBClient) StreamRegex(ctx context.Context, in *StreamRegexRequest, opts ...grpc.CallOption) (GeoDB_StreamRegexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[1], "/api.GeoDB/StreamRegex", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamRegexClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GeoDB_ScanBoundStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamRegexClient interface {
-	Recv() (*StreamRegexResponse, error)
-	grpc.ClientStream
+type geoDBScanBoundStreamServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamRegexClient struct {
-	grpc.ClientStream
+func (x *geoDBScanBoundStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamRegexClient) Recv() (*StreamRegexResponse, error) {
-	m := new(StreamRegexResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func _GeoDB_ScanRegexBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRegexBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(GeoDBServer).ScanRegexBoundStream(m, &geoDBScanRegexBoundStreamServer{stream})
 }
 
-func (c *geoDBClient) StreamPrefix(ctx context.Context, in *StreamPrefixRequest, opts ...grpc.CallOption) (GeoDB_StreamPrefixClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GeoDB_serviceDesc.Streams[2], "/api.GeoDB/StreamPrefix", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &geoDBStreamPrefixClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GeoDB_ScanRegexBoundStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
 
-type GeoDB_StreamPrefixClient interface {
-	Recv() (*StreamPrefixResponse, error)
-	grpc.ClientStream
+type geoDBScanRegexBoundStreamServer struct {
+	grpc.ServerStream
 }
 
-type geoDBStreamPrefixClient struct {
-	grpc.ClientStream
+func (x *geoDBScanRegexBoundStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *geoDBStreamPrefixClient) Recv() (*StreamPrefixResponse, error) {
-	m := new(StreamPrefixResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func _GeoDB_ScanPrefixBoundStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPrefixBoundRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(GeoDBServer).ScanPrefixBoundStream(m, &geoDBScanPrefixBoundStreamServer{stream})
 }
 
-func (c *geoDBClient) ScanBound(ctx context.Context, in *ScanBoundRequest, opts ...grpc.CallOption) (*ScanBoundResponse, error) {
-	out := new(ScanBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanBound", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GeoDB_ScanPrefixBoundStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
 
-func (c *geoDBClient) ScanRegexBound(ctx context.Context, in *ScanRegexBoundRequest, opts ...grpc.CallOption) (*ScanRegexBoundResponse, error) {
-	out := new(ScanRegexBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanRegexBound", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type geoDBScanPrefixBoundStreamServer struct {
+	grpc.ServerStream
 }
 
-func (c *geoDBClient) ScanPrefixBound(ctx context.Context, in *ScanPrefixBoundRequest, opts ...grpc.CallOption) (*ScanPrefixBoundResponse, error) {
-	out := new(ScanPrefixBoundResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/ScanPrefixBound", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *geoDBScanPrefixBoundStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *geoDBClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
-	out := new(GetPointResponse)
-	err := c.cc.Invoke(ctx, "/api.GeoDB/GetPoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _GeoDB_ScanPolygonStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPolygonRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(GeoDBServer).ScanPolygonStream(m, &geoDBScanPolygonStreamServer{stream})
 }
 
-// GeoDBServer is the server API for GeoDB service.
-type GeoDBServer interface {
-	//Ping - input: empty, output: returns ok if server is healthy.
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	//Set - input: an object output: an object detail. Object details are enhanced when the google maps integration is active
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	//Get - input: an array of object keys, output: returns an array of current object details
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	//GetRegex - input: a regex string, output: returns an array of current object details with keys that match the regex pattern
-	GetRegex(context.Context, *GetRegexRequest) (*GetRegexResponse, error)
-	//GetPrefix - input: a prefix string, output: returns an array of current object details with keys that have the given prefix
-	GetPrefix(context.Context, *GetPrefixRequest) (*GetPrefixResponse, error)
-	//GetKeys -  input: none, output: returns all keys in database
-	GetKeys(context.Context, *GetKeysRequest) (*GetKeysResponse, error)
-	//GetRegexKeys -  input: a regex string, output: returns all keys in database that match the regex pattern
-	GetRegexKeys(context.Context, *GetRegexKeysRequest) (*GetRegexKeysResponse, error)
-	//GetPrefixKeys - input: a prefix string, output: returns an array of of keys that have the given prefix
-	GetPrefixKeys(context.Context, *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error)
-	//Delete -  input: an array of object key strings to delete, output: none
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	//Stream -  input: a clientID(optional) and an array of object keys(optional),
-	//output: a stream of object details for realtime, targetted object geolocation updates
-	Stream(*StreamRequest, GeoDB_StreamServer) error
-	//StreamRegex -  input: a clientID(optional) a regex string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the regex pattern
-	StreamRegex(*StreamRegexRequest, GeoDB_StreamRegexServer) error
-	//StreamPrefix -  input: a clientID(optional) a prefix string,
-	//output: a stream of object details for realtime, targetted object geolocation updates that match the prefix pattern
-	StreamPrefix(*StreamPrefixRequest, GeoDB_StreamPrefixServer) error
-	//ScanBound -  input: a geolocation boundary, output: returns an array of current object details that are within the boundary
-	ScanBound(context.Context, *ScanBoundRequest) (*ScanBoundResponse, error)
-	//ScanRegexBound -  input: a geolocation boundary, string-array of unique object ids(optional), output: returns an array of current object details that have keys that match the regex and are within the boundary and
-	ScanRegexBound(context.Context, *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error)
-	//ScanPrefexBound -  input: a geolocation boundary, output: returns an array of current object details that have keys that match the prefix and are within the boundary and
-	ScanPrefixBound(context.Context, *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error)
-	//GetPoint can be used to get an addresses latitude/longitude - google maps integration is required.
-	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+type GeoDB_ScanPolygonStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
 
-// UnimplementedGeoDBServer can be embedded to have forward compatible implementations.
-type UnimplementedGeoDBServer struct {
+type geoDBScanPolygonStreamServer struct {
+	grpc.ServerStream
 }
 
-func (*UnimplementedGeoDBServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (x *geoDBScanPolygonStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+
+func _GeoDB_ScanRegexPolygonStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRegexPolygonRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanRegexPolygonStream(m, &geoDBScanRegexPolygonStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+type GeoDB_ScanRegexPolygonStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetRegex(ctx context.Context, req *GetRegexRequest) (*GetRegexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegex not implemented")
+
+type geoDBScanRegexPolygonStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPrefix(ctx context.Context, req *GetPrefixRequest) (*GetPrefixResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefix not implemented")
+
+func (x *geoDBScanRegexPolygonStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) GetKeys(ctx context.Context, req *GetKeysRequest) (*GetKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
+
+func _GeoDB_ScanPrefixPolygonStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPrefixPolygonRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanPrefixPolygonStream(m, &geoDBScanPrefixPolygonStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) GetRegexKeys(ctx context.Context, req *GetRegexKeysRequest) (*GetRegexKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegexKeys not implemented")
+
+type GeoDB_ScanPrefixPolygonStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPrefixKeys(ctx context.Context, req *GetPrefixKeysRequest) (*GetPrefixKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrefixKeys not implemented")
+
+type geoDBScanPrefixPolygonStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (x *geoDBScanPrefixPolygonStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) Stream(req *StreamRequest, srv GeoDB_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+
+func _GeoDB_ScanBoxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanBoxRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanBoxStream(m, &geoDBScanBoxStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) StreamRegex(req *StreamRegexRequest, srv GeoDB_StreamRegexServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamRegex not implemented")
+
+type GeoDB_ScanBoxStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) StreamPrefix(req *StreamPrefixRequest, srv GeoDB_StreamPrefixServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamPrefix not implemented")
+
+type geoDBScanBoxStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) ScanBound(ctx context.Context, req *ScanBoundRequest) (*ScanBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanBound not implemented")
+
+func (x *geoDBScanBoxStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedGeoDBServer) ScanRegexBound(ctx context.Context, req *ScanRegexBoundRequest) (*ScanRegexBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanRegexBound not implemented")
+
+func _GeoDB_ScanRegexBoxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRegexBoxRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeoDBServer).ScanRegexBoxStream(m, &geoDBScanRegexBoxStreamServer{stream})
 }
-func (*UnimplementedGeoDBServer) ScanPrefixBound(ctx context.Context, req *ScanPrefixBoundRequest) (*ScanPrefixBoundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScanPrefixBound not implemented")
+
+type GeoDB_ScanRegexBoxStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
 }
-func (*UnimplementedGeoDBServer) GetPoint(ctx context.Context, req *GetPointRequest) (*GetPointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+
+type geoDBScanRegexBoxStreamServer struct {
+	grpc.ServerStream
 }
 
-func RegisterGeoDBServer(s *grpc.Server, srv GeoDBServer) {
-	s.RegisterService(&_GeoDB_serviceDesc, srv)
+func (x *geoDBScanRegexBoxStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _GeoDB_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GeoDBServer).Ping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.GeoDB/Ping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Ping(ctx, req.(*PingRequest))
+func _GeoDB_ScanPrefixBoxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanPrefixBoxRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GeoDBServer).ScanPrefixBoxStream(m, &geoDBScanPrefixBoxStreamServer{stream})
 }
 
-func _GeoDB_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
+type GeoDB_ScanPrefixBoxStreamServer interface {
+	Send(*ObjectResult) error
+	grpc.ServerStream
+}
+
+type geoDBScanPrefixBoxStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *geoDBScanPrefixBoxStreamServer) Send(m *ObjectResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GeoDB_Nearest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Set(ctx, in)
+		return srv.(GeoDBServer).Nearest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Set",
+		FullMethod: "/api.GeoDB/Nearest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Set(ctx, req.(*SetRequest))
+		return srv.(GeoDBServer).Nearest(ctx, req.(*NearestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _GeoDB_AddMetadataIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMetadataIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Get(ctx, in)
+		return srv.(GeoDBServer).AddMetadataIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Get",
+		FullMethod: "/api.GeoDB/AddMetadataIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Get(ctx, req.(*GetRequest))
+		return srv.(GeoDBServer).AddMetadataIndex(ctx, req.(*AddMetadataIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexRequest)
+func _GeoDB_RemoveMetadataIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMetadataIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegex(ctx, in)
+		return srv.(GeoDBServer).RemoveMetadataIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegex",
+		FullMethod: "/api.GeoDB/RemoveMetadataIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegex(ctx, req.(*GetRegexRequest))
+		return srv.(GeoDBServer).RemoveMetadataIndex(ctx, req.(*RemoveMetadataIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixRequest)
+func _GeoDB_GetMetadataIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataIndexesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefix(ctx, in)
+		return srv.(GeoDBServer).GetMetadataIndexes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefix",
+		FullMethod: "/api.GeoDB/GetMetadataIndexes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefix(ctx, req.(*GetPrefixRequest))
+		return srv.(GeoDBServer).GetMetadataIndexes(ctx, req.(*GetMetadataIndexesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetKeysRequest)
+func _GeoDB_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetKeys(ctx, in)
+		return srv.(GeoDBServer).GetHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetKeys",
+		FullMethod: "/api.GeoDB/GetHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetKeys(ctx, req.(*GetKeysRequest))
+		return srv.(GeoDBServer).GetHistory(ctx, req.(*GetHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetRegexKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegexKeysRequest)
+func _GeoDB_SetGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGeofenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, in)
+		return srv.(GeoDBServer).SetGeofence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetRegexKeys",
+		FullMethod: "/api.GeoDB/SetGeofence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetRegexKeys(ctx, req.(*GetRegexKeysRequest))
+		return srv.(GeoDBServer).SetGeofence(ctx, req.(*SetGeofenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPrefixKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPrefixKeysRequest)
+func _GeoDB_GetGeofences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeofencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, in)
+		return srv.(GeoDBServer).GetGeofences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPrefixKeys",
+		FullMethod: "/api.GeoDB/GetGeofences",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPrefixKeys(ctx, req.(*GetPrefixKeysRequest))
+		return srv.(GeoDBServer).GetGeofences(ctx, req.(*GetGeofencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _GeoDB_DeleteGeofences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGeofencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).Delete(ctx, in)
+		return srv.(GeoDBServer).DeleteGeofences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/Delete",
+		FullMethod: "/api.GeoDB/DeleteGeofences",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(GeoDBServer).DeleteGeofences(ctx, req.(*DeleteGeofencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
+func _GeoDB_StreamGeofenceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGeofenceEventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GeoDBServer).Stream(m, &geoDBStreamServer{stream})
+	return srv.(GeoDBServer).StreamGeofenceEvents(m, &geoDBStreamGeofenceEventsServer{stream})
 }
 
-type GeoDB_StreamServer interface {
-	Send(*StreamResponse) error
+type GeoDB_StreamGeofenceEventsServer interface {
+	Send(*StreamGeofenceEventsResponse) error
 	grpc.ServerStream
 }
 
-type geoDBStreamServer struct {
+type geoDBStreamGeofenceEventsServer struct {
 	grpc.ServerStream
 }
 
-func (x *geoDBStreamServer) Send(m *StreamResponse) error {
+func (x *geoDBStreamGeofenceEventsServer) Send(m *StreamGeofenceEventsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GeoDB_StreamRegex_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRegexRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GeoDB_SetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GeoDBServer).StreamRegex(m, &geoDBStreamRegexServer{stream})
-}
-
-type GeoDB_StreamRegexServer interface {
-	Send(*StreamRegexResponse) error
-	grpc.ServerStream
-}
-
-type geoDBStreamRegexServer struct {
-	grpc.ServerStream
-}
-
-func (x *geoDBStreamRegexServer) Send(m *StreamRegexResponse) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(GeoDBServer).SetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/SetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).SetWebhook(ctx, req.(*SetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_StreamPrefix_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamPrefixRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GeoDB_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GeoDBServer).StreamPrefix(m, &geoDBStreamPrefixServer{stream})
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetWebhooks(ctx, req.(*GetWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type GeoDB_StreamPrefixServer interface {
-	Send(*StreamPrefixResponse) error
-	grpc.ServerStream
+func _GeoDB_DeleteWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).DeleteWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/DeleteWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).DeleteWebhooks(ctx, req.(*DeleteWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type geoDBStreamPrefixServer struct {
-	grpc.ServerStream
+func _GeoDB_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).GetDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/GetDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).GetDeadLetters(ctx, req.(*GetDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *geoDBStreamPrefixServer) Send(m *StreamPrefixResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GeoDB_RedeliverDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoDBServer).RedeliverDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GeoDB/RedeliverDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoDBServer).RedeliverDeadLetters(ctx, req.(*RedeliverDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanBoundRequest)
+func _GeoDB_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanBound(ctx, in)
+		return srv.(GeoDBServer).GetPoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanBound",
+		FullMethod: "/api.GeoDB/GetPoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanBound(ctx, req.(*ScanBoundRequest))
+		return srv.(GeoDBServer).GetPoint(ctx, req.(*GetPointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanRegexBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegexBoundRequest)
+func _GeoDB_GetMapsCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapsCacheStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, in)
+		return srv.(GeoDBServer).GetMapsCacheStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanRegexBound",
+		FullMethod: "/api.GeoDB/GetMapsCacheStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanRegexBound(ctx, req.(*ScanRegexBoundRequest))
+		return srv.(GeoDBServer).GetMapsCacheStats(ctx, req.(*GetMapsCacheStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_ScanPrefixBound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanPrefixBoundRequest)
+func _GeoDB_PurgeMapsCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMapsCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, in)
+		return srv.(GeoDBServer).PurgeMapsCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/ScanPrefixBound",
+		FullMethod: "/api.GeoDB/PurgeMapsCache",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).ScanPrefixBound(ctx, req.(*ScanPrefixBoundRequest))
+		return srv.(GeoDBServer).PurgeMapsCache(ctx, req.(*PurgeMapsCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GeoDB_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPointRequest)
+func _GeoDB_BoundMapsCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoundMapsCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GeoDBServer).GetPoint(ctx, in)
+		return srv.(GeoDBServer).BoundMapsCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.GeoDB/GetPoint",
+		FullMethod: "/api.GeoDB/BoundMapsCache",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoDBServer).GetPoint(ctx, req.(*GetPointRequest))
+		return srv.(GeoDBServer).BoundMapsCache(ctx, req.(*BoundMapsCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2746,6 +8593,10 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Set",
 			Handler:    _GeoDB_Set_Handler,
 		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _GeoDB_BatchSet_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _GeoDB_Get_Handler,
@@ -2786,12 +8637,106 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScanPrefixBound",
 			Handler:    _GeoDB_ScanPrefixBound_Handler,
 		},
+		{
+			MethodName: "ScanPolygon",
+			Handler:    _GeoDB_ScanPolygon_Handler,
+		},
+		{
+			MethodName: "ScanRegexPolygon",
+			Handler:    _GeoDB_ScanRegexPolygon_Handler,
+		},
+		{
+			MethodName: "ScanPrefixPolygon",
+			Handler:    _GeoDB_ScanPrefixPolygon_Handler,
+		},
+		{
+			MethodName: "ScanBox",
+			Handler:    _GeoDB_ScanBox_Handler,
+		},
+		{
+			MethodName: "ScanRegexBox",
+			Handler:    _GeoDB_ScanRegexBox_Handler,
+		},
+		{
+			MethodName: "ScanPrefixBox",
+			Handler:    _GeoDB_ScanPrefixBox_Handler,
+		},
+		{
+			MethodName: "Nearest",
+			Handler:    _GeoDB_Nearest_Handler,
+		},
+		{
+			MethodName: "AddMetadataIndex",
+			Handler:    _GeoDB_AddMetadataIndex_Handler,
+		},
+		{
+			MethodName: "RemoveMetadataIndex",
+			Handler:    _GeoDB_RemoveMetadataIndex_Handler,
+		},
+		{
+			MethodName: "GetMetadataIndexes",
+			Handler:    _GeoDB_GetMetadataIndexes_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _GeoDB_GetHistory_Handler,
+		},
+		{
+			MethodName: "SetGeofence",
+			Handler:    _GeoDB_SetGeofence_Handler,
+		},
+		{
+			MethodName: "GetGeofences",
+			Handler:    _GeoDB_GetGeofences_Handler,
+		},
+		{
+			MethodName: "DeleteGeofences",
+			Handler:    _GeoDB_DeleteGeofences_Handler,
+		},
+		{
+			MethodName: "SetWebhook",
+			Handler:    _GeoDB_SetWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _GeoDB_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhooks",
+			Handler:    _GeoDB_DeleteWebhooks_Handler,
+		},
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _GeoDB_GetDeadLetters_Handler,
+		},
+		{
+			MethodName: "RedeliverDeadLetters",
+			Handler:    _GeoDB_RedeliverDeadLetters_Handler,
+		},
 		{
 			MethodName: "GetPoint",
 			Handler:    _GeoDB_GetPoint_Handler,
 		},
+		{
+			MethodName: "GetMapsCacheStats",
+			Handler:    _GeoDB_GetMapsCacheStats_Handler,
+		},
+		{
+			MethodName: "PurgeMapsCache",
+			Handler:    _GeoDB_PurgeMapsCache_Handler,
+		},
+		{
+			MethodName: "BoundMapsCache",
+			Handler:    _GeoDB_BoundMapsCache_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SetStream",
+			Handler:       _GeoDB_SetStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Stream",
 			Handler:       _GeoDB_Stream_Handler,
@@ -2807,6 +8752,76 @@ var _GeoDB_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GeoDB_StreamPrefix_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamBound",
+			Handler:       _GeoDB_StreamBound_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetStream",
+			Handler:       _GeoDB_GetStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRegexStream",
+			Handler:       _GeoDB_GetRegexStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPrefixStream",
+			Handler:       _GeoDB_GetPrefixStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanBoundStream",
+			Handler:       _GeoDB_ScanBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanRegexBoundStream",
+			Handler:       _GeoDB_ScanRegexBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPrefixBoundStream",
+			Handler:       _GeoDB_ScanPrefixBoundStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPolygonStream",
+			Handler:       _GeoDB_ScanPolygonStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanRegexPolygonStream",
+			Handler:       _GeoDB_ScanRegexPolygonStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPrefixPolygonStream",
+			Handler:       _GeoDB_ScanPrefixPolygonStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanBoxStream",
+			Handler:       _GeoDB_ScanBoxStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanRegexBoxStream",
+			Handler:       _GeoDB_ScanRegexBoxStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanPrefixBoxStream",
+			Handler:       _GeoDB_ScanPrefixBoxStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGeofenceEvents",
+			Handler:       _GeoDB_StreamGeofenceEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
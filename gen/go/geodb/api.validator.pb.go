@@ -28,6 +28,51 @@ func (this *Bound) Validate() error {
 	}
 	return nil
 }
+func (this *Ring) Validate() error {
+	if len(this.Points) < 3 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Points", fmt.Errorf(`value '%v' must contain at least 3 elements`, this.Points))
+	}
+	for _, item := range this.Points {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Points", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Polygon) Validate() error {
+	if len(this.Rings) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Rings", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Rings))
+	}
+	for _, item := range this.Rings {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Rings", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Box) Validate() error {
+	if nil == this.SouthWest {
+		return github_com_mwitkow_go_proto_validators.FieldError("SouthWest", fmt.Errorf("message must exist"))
+	}
+	if this.SouthWest != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.SouthWest); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("SouthWest", err)
+		}
+	}
+	if nil == this.NorthEast {
+		return github_com_mwitkow_go_proto_validators.FieldError("NorthEast", fmt.Errorf("message must exist"))
+	}
+	if this.NorthEast != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NorthEast); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NorthEast", err)
+		}
+	}
+	return nil
+}
 
 var _regex_Object_Key = regexp.MustCompile(`^.{1,225}$`)
 
@@ -112,7 +157,101 @@ func (this *ObjectDetail) Validate() error {
 	}
 	return nil
 }
+
+var _regex_Geofence_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *Geofence) Validate() error {
+	if !_regex_Geofence_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	if this.Circle != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Circle); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Circle", err)
+		}
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *GeofenceEvent) Validate() error {
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *DwellState) Validate() error {
+	return nil
+}
+
+var _regex_Webhook_Id = regexp.MustCompile(`^.{1,225}$`)
+var _regex_Webhook_Url = regexp.MustCompile(`^https?://.+$`)
+
+func (this *Webhook) Validate() error {
+	if !_regex_Webhook_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Id))
+	}
+	if !_regex_Webhook_Url.MatchString(this.Url) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Url", fmt.Errorf(`value '%v' must be a string conforming to regex "^https?://.+$"`, this.Url))
+	}
+	return nil
+}
+func (this *WebhookPayload) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	if this.GeofenceEvent != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.GeofenceEvent); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("GeofenceEvent", err)
+		}
+	}
+	return nil
+}
+func (this *WebhookDelivery) Validate() error {
+	if this.Payload != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Payload); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Payload", err)
+		}
+	}
+	return nil
+}
+func (this *MetadataFilter) Validate() error {
+	for _, item := range this.Conditions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Conditions", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_MetadataCondition_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *MetadataCondition) Validate() error {
+	if !_regex_MetadataCondition_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	return nil
+}
 func (this *StreamRequest) Validate() error {
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
 	return nil
 }
 func (this *StreamResponse) Validate() error {
@@ -130,6 +269,11 @@ func (this *StreamRegexRequest) Validate() error {
 	if !_regex_StreamRegexRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
 	return nil
 }
 func (this *StreamRegexResponse) Validate() error {
@@ -147,6 +291,11 @@ func (this *StreamPrefixRequest) Validate() error {
 	if !_regex_StreamPrefixRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
 	return nil
 }
 func (this *StreamPrefixResponse) Validate() error {
@@ -157,6 +306,32 @@ func (this *StreamPrefixResponse) Validate() error {
 	}
 	return nil
 }
+func (this *StreamBoundRequest) Validate() error {
+	if this.Circle != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Circle); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Circle", err)
+		}
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	return nil
+}
+func (this *StreamBoundResponse) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
 func (this *SetRequest) Validate() error {
 	if nil == this.Object {
 		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf("message must exist"))
@@ -166,6 +341,56 @@ func (this *SetRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
 		}
 	}
+	if this.Precondition != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Precondition); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Precondition", err)
+		}
+	}
+	return nil
+}
+func (this *Precondition) Validate() error {
+	return nil
+}
+func (this *SetStreamRequest) Validate() error {
+	if nil == this.Object {
+		return github_com_mwitkow_go_proto_validators.FieldError("Object", fmt.Errorf("message must exist"))
+	}
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	if this.Precondition != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Precondition); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Precondition", err)
+		}
+	}
+	return nil
+}
+func (this *SetStreamResponse) Validate() error {
+	return nil
+}
+func (this *BatchSetRequest) Validate() error {
+	if len(this.Objects) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Objects", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Objects))
+	}
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BatchSetResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *SetResponse) Validate() error {
@@ -176,7 +401,18 @@ func (this *SetResponse) Validate() error {
 	}
 	return nil
 }
+func (this *ObjectResult) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
 func (this *GetKeysRequest) Validate() error {
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetKeysResponse) Validate() error {
@@ -189,6 +425,9 @@ func (this *GetPrefixKeysRequest) Validate() error {
 	if !_regex_GetPrefixKeysRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetPrefixKeysResponse) Validate() error {
@@ -201,12 +440,23 @@ func (this *GetRegexKeysRequest) Validate() error {
 	if !_regex_GetRegexKeysRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetRegexKeysResponse) Validate() error {
 	return nil
 }
 func (this *GetRequest) Validate() error {
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetResponse) Validate() error {
@@ -220,6 +470,14 @@ func (this *GetRegexRequest) Validate() error {
 	if !_regex_GetRegexRequest_Regex.MatchString(this.Regex) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Regex", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Regex))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetRegexResponse) Validate() error {
@@ -233,6 +491,14 @@ func (this *GetPrefixRequest) Validate() error {
 	if !_regex_GetPrefixRequest_Prefix.MatchString(this.Prefix) {
 		return github_com_mwitkow_go_proto_validators.FieldError("Prefix", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Prefix))
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *GetPrefixResponse) Validate() error {
@@ -251,6 +517,14 @@ func (this *ScanBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *ScanBoundResponse) Validate() error {
@@ -263,6 +537,14 @@ func (this *ScanPrefixBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *ScanPrefixBoundResponse) Validate() error {
@@ -275,12 +557,333 @@ func (this *ScanRegexBoundRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Bound", err)
 		}
 	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
 	return nil
 }
 func (this *ScanRegexBoundResponse) Validate() error {
 	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
+func (this *ScanPolygonRequest) Validate() error {
+	if nil == this.Polygon {
+		return github_com_mwitkow_go_proto_validators.FieldError("Polygon", fmt.Errorf("message must exist"))
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanPolygonResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanPrefixPolygonRequest) Validate() error {
+	if nil == this.Polygon {
+		return github_com_mwitkow_go_proto_validators.FieldError("Polygon", fmt.Errorf("message must exist"))
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanPrefixPolygonResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanRegexPolygonRequest) Validate() error {
+	if nil == this.Polygon {
+		return github_com_mwitkow_go_proto_validators.FieldError("Polygon", fmt.Errorf("message must exist"))
+	}
+	if this.Polygon != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Polygon); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Polygon", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanRegexPolygonResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanBoxRequest) Validate() error {
+	if nil == this.Box {
+		return github_com_mwitkow_go_proto_validators.FieldError("Box", fmt.Errorf("message must exist"))
+	}
+	if this.Box != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Box); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Box", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanBoxResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanPrefixBoxRequest) Validate() error {
+	if nil == this.Box {
+		return github_com_mwitkow_go_proto_validators.FieldError("Box", fmt.Errorf("message must exist"))
+	}
+	if this.Box != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Box); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Box", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanPrefixBoxResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ScanRegexBoxRequest) Validate() error {
+	if nil == this.Box {
+		return github_com_mwitkow_go_proto_validators.FieldError("Box", fmt.Errorf("message must exist"))
+	}
+	if this.Box != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Box); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Box", err)
+		}
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ScanRegexBoxResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *NearestRequest) Validate() error {
+	if nil == this.Point {
+		return github_com_mwitkow_go_proto_validators.FieldError("Point", fmt.Errorf("message must exist"))
+	}
+	if this.Point != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Point); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Point", err)
+		}
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	return nil
+}
+func (this *NearestResult) Validate() error {
+	if this.Object != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Object); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Object", err)
+		}
+	}
+	return nil
+}
+func (this *NearestResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_AddMetadataIndexRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *AddMetadataIndexRequest) Validate() error {
+	if !_regex_AddMetadataIndexRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	return nil
+}
+func (this *AddMetadataIndexResponse) Validate() error {
+	return nil
+}
+
+var _regex_RemoveMetadataIndexRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *RemoveMetadataIndexRequest) Validate() error {
+	if !_regex_RemoveMetadataIndexRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	return nil
+}
+func (this *RemoveMetadataIndexResponse) Validate() error {
+	return nil
+}
+func (this *GetMetadataIndexesRequest) Validate() error {
+	return nil
+}
+func (this *GetMetadataIndexesResponse) Validate() error {
+	return nil
+}
+
+var _regex_GetHistoryRequest_Key = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *GetHistoryRequest) Validate() error {
+	if !_regex_GetHistoryRequest_Key.MatchString(this.Key) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Key", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Key))
+	}
+	return nil
+}
+func (this *GetHistoryResponse) Validate() error {
+	for _, item := range this.Objects {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Objects", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetGeofenceRequest) Validate() error {
+	if nil == this.Geofence {
+		return github_com_mwitkow_go_proto_validators.FieldError("Geofence", fmt.Errorf("message must exist"))
+	}
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+func (this *SetGeofenceResponse) Validate() error {
+	if this.Geofence != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Geofence); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Geofence", err)
+		}
+	}
+	return nil
+}
+func (this *GetGeofencesRequest) Validate() error {
+	return nil
+}
+func (this *GetGeofencesResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *DeleteGeofencesRequest) Validate() error {
+	return nil
+}
+func (this *DeleteGeofencesResponse) Validate() error {
+	return nil
+}
+func (this *StreamGeofenceEventsRequest) Validate() error {
+	return nil
+}
+func (this *StreamGeofenceEventsResponse) Validate() error {
+	if this.Event != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Event); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Event", err)
+		}
+	}
+	return nil
+}
+func (this *SetWebhookRequest) Validate() error {
+	if nil == this.Webhook {
+		return github_com_mwitkow_go_proto_validators.FieldError("Webhook", fmt.Errorf("message must exist"))
+	}
+	if this.Webhook != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Webhook); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Webhook", err)
+		}
+	}
+	return nil
+}
+func (this *SetWebhookResponse) Validate() error {
+	if this.Webhook != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Webhook); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Webhook", err)
+		}
+	}
+	return nil
+}
+func (this *GetWebhooksRequest) Validate() error {
+	return nil
+}
+func (this *GetWebhooksResponse) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *DeleteWebhooksRequest) Validate() error {
+	return nil
+}
+func (this *DeleteWebhooksResponse) Validate() error {
+	return nil
+}
+func (this *GetDeadLettersRequest) Validate() error {
+	return nil
+}
+func (this *GetDeadLettersResponse) Validate() error {
+	for _, item := range this.Deliveries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Deliveries", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RedeliverDeadLettersRequest) Validate() error {
+	return nil
+}
+func (this *RedeliverDeadLettersResponse) Validate() error {
+	return nil
+}
 func (this *GetPointRequest) Validate() error {
 	return nil
 }
@@ -292,6 +895,37 @@ func (this *GetPointResponse) Validate() error {
 	}
 	return nil
 }
+func (this *MapsCacheStats) Validate() error {
+	return nil
+}
+func (this *GetMapsCacheStatsRequest) Validate() error {
+	return nil
+}
+func (this *GetMapsCacheStatsResponse) Validate() error {
+	for _, item := range this.Caches {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Caches", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PurgeMapsCacheRequest) Validate() error {
+	return nil
+}
+func (this *PurgeMapsCacheResponse) Validate() error {
+	return nil
+}
+func (this *BoundMapsCacheRequest) Validate() error {
+	if !(this.MaxEntries > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxEntries", fmt.Errorf(`value '%v' must be greater than '-1'`, this.MaxEntries))
+	}
+	return nil
+}
+func (this *BoundMapsCacheResponse) Validate() error {
+	return nil
+}
 func (this *PingRequest) Validate() error {
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/jsonpb"
 	"googlemaps.github.io/maps"
+	"time"
 )
 
 var jpb = jsonpb.Marshaler{}
@@ -23,6 +24,24 @@ func ToTravelMode(mode api.TravelMode) maps.Mode {
 	}
 }
 
+// ZoneOffset returns the offset from UTC in seconds of the IANA timezone at the given time and whether daylight saving time is in effect
+func ZoneOffset(zone string, at time.Time) (int32, bool, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return 0, false, err
+	}
+	at = at.In(loc)
+	_, offset := at.Zone()
+	// the standard offset is the smaller one of winter and summer
+	_, january := time.Date(at.Year(), time.January, 1, 0, 0, 0, 0, loc).Zone()
+	_, july := time.Date(at.Year(), time.July, 1, 0, 0, 0, 0, loc).Zone()
+	standard := january
+	if july < standard {
+		standard = july
+	}
+	return int32(offset), offset > standard, nil
+}
+
 func PrettyJson(msg proto.Message) string {
 	str, _ := jpb.MarshalToString(msg)
 	return fmt.Sprintln(str)
@@ -2,18 +2,38 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	geodb "github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"github.com/autom8ter/geodb/helpers"
+	"github.com/autom8ter/geodb/maps"
 	"github.com/autom8ter/geodb/server"
 	"github.com/autom8ter/geodb/services"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/autom8ter/geodb/webhook"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
 
 var (
 	geoDB      *services.GeoDB
+	streamHub  *stream.Hub
+	badgerDB   *badger.DB
 	coorsField = &api.Point{
 		Lat: 39.756378173828125,
 		Lon: -104.99414825439453,
@@ -32,12 +52,89 @@ var (
 	}
 )
 
+// prefixStream is an in memory api.GeoDB_StreamPrefixServer
+type prefixStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	objects chan *api.ObjectDetail
+}
+
+func (s *prefixStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *prefixStream) Send(resp *api.StreamPrefixResponse) error {
+	s.objects <- resp.Object
+	return nil
+}
+
+// boundStream is an in memory api.GeoDB_StreamBoundServer
+type boundStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *api.StreamBoundResponse
+}
+
+func (s *boundStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *boundStream) Send(resp *api.StreamBoundResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+// setStream is an in memory api.GeoDB_SetStreamServer that receives requests until the channel is closed
+type setStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *api.SetStreamRequest
+	responses chan *api.SetStreamResponse
+}
+
+func (s *setStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *setStream) Recv() (*api.SetStreamRequest, error) {
+	r, ok := <-s.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return r, nil
+}
+
+func (s *setStream) Send(resp *api.SetStreamResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+// resultStream is an in memory server stream of a streamed listing
+type resultStream struct {
+	grpc.ServerStream
+	results []*api.ObjectResult
+}
+
+func (s *resultStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *resultStream) Send(resp *api.ObjectResult) error {
+	s.results = append(s.results, resp)
+	return nil
+}
+
 func TestMain(t *testing.M) {
 	db, hub, gmaps, err := server.GetDeps()
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	geoDB = services.NewGeoDB(db, hub, gmaps)
+	geoDB = services.NewGeoDB(db, hub, gmaps, nil)
+	streamHub = hub
+	badgerDB = db
+	go hub.StartObjectStream(context.Background())
+	go hub.StartGeofenceStream(context.Background())
+	go geodb.WatchEnrichments(context.Background())
 	os.Exit(t.Run())
 }
 
@@ -182,6 +279,235 @@ func TestGetRegexs(t *testing.T) {
 	}
 }
 
+// setMetadataObjects sets a stadium, an arena and a mall with type metadata next to the objects of TestSet and returns a func deleting them
+func setMetadataObjects(t *testing.T) func() {
+	objects := []*api.Object{
+		{
+			Key:      "metadata_coors",
+			Point:    coorsField,
+			Radius:   100,
+			Metadata: map[string]string{"type": "stadium"},
+		},
+		{
+			Key:      "metadata_pepsi_center",
+			Point:    pepsiCenter,
+			Radius:   100,
+			Metadata: map[string]string{"type": "arena"},
+		},
+		{
+			Key:      "metadata_cherry_creek_mall",
+			Point:    cherryCreekMall,
+			Radius:   100,
+			Metadata: map[string]string{"type": "mall"},
+		},
+	}
+	var keys []string
+	for _, obj := range objects {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: obj}); err != nil {
+			t.Fatal(err.Error())
+		}
+		keys = append(keys, obj.Key)
+	}
+	return func() {
+		if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+}
+
+func TestGetMetadataFilter(t *testing.T) {
+	defer setMetadataObjects(t)()
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_In,
+					Values:   []string{"arena", "mall"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	prefixResp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "metadata_",
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_Equal,
+					Values:   []string{"stadium"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefixResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	resp, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "owner",
+					Operator: api.MetadataOperator_Exists,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+	_, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_Equal,
+				},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected invalid filter error")
+	}
+}
+
+func TestMetadataIndex(t *testing.T) {
+	defer setMetadataObjects(t)()
+	if _, err := geoDB.AddMetadataIndex(context.Background(), &api.AddMetadataIndexRequest{
+		Key: "type",
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	indexes, err := geoDB.GetMetadataIndexes(context.Background(), &api.GetMetadataIndexesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(indexes.Keys) != 1 || indexes.Keys[0] != "type" {
+		t.Fatal("expected type index")
+	}
+	typeFilter := func(value string) *api.MetadataFilter {
+		return &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_Equal,
+					Values:   []string{value},
+				},
+			},
+		}
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: typeFilter("arena"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	kiosk := &api.Object{
+		Key:      "indexed_kiosk",
+		Point:    saintJosephHospital,
+		Radius:   10,
+		Metadata: map[string]string{"type": "kiosk"},
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: kiosk}); err != nil {
+		t.Fatal(err.Error())
+	}
+	scanResp, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: saintJosephHospital,
+			Radius: 1000,
+		},
+		Filter: typeFilter("kiosk"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(scanResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	kiosk.Metadata["type"] = "cart"
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: kiosk}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: typeFilter("kiosk"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+	prefixResp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+		Prefix: "indexed_",
+		Filter: typeFilter("cart"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefixResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	indexEntries := func() []string {
+		prefix := []byte("_geodb_metadata_" + base64.RawStdEncoding.EncodeToString([]byte("type")) + "_")
+		var entries []string
+		if err := badgerDB.View(func(txn *badger.Txn) error {
+			iter := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer iter.Close()
+			for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+				if strings.HasSuffix(string(iter.Item().Key()), "_"+kiosk.Key) {
+					entries = append(entries, string(iter.Item().Key()))
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		return entries
+	}
+	// the entry of the previous value is replaced when the value becomes empty and removed with the key
+	kiosk.Metadata["type"] = ""
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: kiosk}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if entries := indexEntries(); len(entries) != 1 {
+		t.Fatalf("expected 1 index entry, got: %v", entries)
+	}
+	delete(kiosk.Metadata, "type")
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{Object: kiosk}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if entries := indexEntries(); len(entries) != 0 {
+		t.Fatalf("expected 0 index entries, got: %v", entries)
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{kiosk.Key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err = geoDB.Get(context.Background(), &api.GetRequest{
+		Filter: typeFilter("cart"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+}
+
 func TestGetKeys(t *testing.T) {
 	resp, err := geoDB.GetKeys(context.Background(), &api.GetKeysRequest{})
 	if err != nil {
@@ -226,7 +552,7 @@ func TestGetRegexKeys(t *testing.T) {
 }
 
 func TestScanBounds(t *testing.T) {
-	_, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+	resp, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
 		Bound: &api.Bound{
 			Center: coorsField,
 			Radius: 5000,
@@ -236,38 +562,2220 @@ func TestScanBounds(t *testing.T) {
 	if err != nil {
 		t.Fatal(err.Error())
 	}
+	if len(resp.Objects) != 3 {
+		t.Fatal("expected 3 results")
+	}
+	resp, err = geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 2000,
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	defer setMetadataObjects(t)()
+	resp, err = geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 5000,
+		},
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_Exists,
+				},
+				{
+					Key:      "type",
+					Operator: api.MetadataOperator_NotEqual,
+					Values:   []string{"stadium"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
 }
 
-func TestDelete(t *testing.T) {
-	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
-		Keys: []string{"testing_pepsi_center"},
+func TestScanPrefixBounds(t *testing.T) {
+	resp, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 5000,
+		},
+		Prefix: "malls_",
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	resp, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
-		Prefix: "testing_",
+	if len(resp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestScanRegexBounds(t *testing.T) {
+	resp, err := geoDB.ScanRegexBound(context.Background(), &api.ScanRegexBoundRequest{
+		Bound: &api.Bound{
+			Center: coorsField,
+			Radius: 2000,
+		},
+		Regex: "testing_*",
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	if len(resp.Keys) != 1 {
-		t.Fatal("expected 1 results")
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
 	}
 }
 
-func TestDeleteAll(t *testing.T) {
-	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
-		Keys: []string{"*"},
+func TestScanBoundAntimeridian(t *testing.T) {
+	prefix := fmt.Sprintf("antimeridian_buoy_%d_", time.Now().UnixNano())
+	var keys []string
+	for i, lon := range []float64{179.99, -179.99, 179} {
+		keys = append(keys, fmt.Sprintf("%s%d", prefix, i))
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  &api.Point{Lat: -17.7, Lon: lon},
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	bound := &api.Bound{
+		Center: &api.Point{Lat: -17.7, Lon: 179.99},
+		Radius: 5000,
+	}
+	resp, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+		Bound:  bound,
+		Prefix: prefix,
 	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	resp, err := geoDB.Get(context.Background(), &api.GetRequest{})
+	if len(resp.Objects) != 2 || resp.Objects[keys[2]] != nil {
+		t.Fatalf("expected the 2 objects next to the antimeridian, got: %v", len(resp.Objects))
+	}
+	regexResp, err := geoDB.ScanRegexBound(context.Background(), &api.ScanRegexBoundRequest{
+		Bound: bound,
+		Regex: "^" + prefix,
+	})
 	if err != nil {
 		t.Fatal(err.Error())
 	}
-	if len(resp.Objects) != 0 {
-		t.Fatal("expected 0 results")
+	if len(regexResp.Objects) != 2 {
+		t.Fatalf("expected the 2 objects next to the antimeridian, got: %v", len(regexResp.Objects))
+	}
+	keysResp, err := geoDB.ScanBound(context.Background(), &api.ScanBoundRequest{
+		Bound: bound,
+		Keys:  keys,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keysResp.Objects) != 2 {
+		t.Fatalf("expected the 2 objects next to the antimeridian, got: %v", len(keysResp.Objects))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestScanPolygon(t *testing.T) {
+	exterior := &api.Ring{
+		Points: []*api.Point{
+			{Lat: 39.74, Lon: -105.02},
+			{Lat: 39.77, Lon: -105.02},
+			{Lat: 39.77, Lon: -104.98},
+			{Lat: 39.74, Lon: -104.98},
+		},
+	}
+	resp, err := geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+		Polygon: &api.Polygon{
+			Rings: []*api.Ring{exterior},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	hole := &api.Ring{
+		Points: []*api.Point{
+			{Lat: 39.745, Lon: -105.012},
+			{Lat: 39.752, Lon: -105.012},
+			{Lat: 39.752, Lon: -105.003},
+			{Lat: 39.745, Lon: -105.003},
+		},
+	}
+	prefixResp, err := geoDB.ScanPrefixPolygon(context.Background(), &api.ScanPrefixPolygonRequest{
+		Polygon: &api.Polygon{
+			Rings: []*api.Ring{exterior, hole},
+		},
+		Prefix: "testing_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefixResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	if _, ok := prefixResp.Objects["testing_coors"]; !ok {
+		t.Fatal("expected testing_coors")
+	}
+	defer setMetadataObjects(t)()
+	arenas := &api.MetadataFilter{
+		Conditions: []*api.MetadataCondition{
+			{
+				Key:      "type",
+				Operator: api.MetadataOperator_Equal,
+				Values:   []string{"arena"},
+			},
+		},
+	}
+	resp, err = geoDB.ScanPolygon(context.Background(), &api.ScanPolygonRequest{
+		Polygon: &api.Polygon{
+			Rings: []*api.Ring{exterior},
+		},
+		Filter: arenas,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["metadata_pepsi_center"] == nil {
+		t.Fatal("expected metadata_pepsi_center")
+	}
+	regexResp, err := geoDB.ScanRegexPolygon(context.Background(), &api.ScanRegexPolygonRequest{
+		Polygon: &api.Polygon{
+			Rings: []*api.Ring{exterior},
+		},
+		Regex:  "^metadata_",
+		Filter: arenas,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(regexResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	prefixResp, err = geoDB.ScanPrefixPolygon(context.Background(), &api.ScanPrefixPolygonRequest{
+		Polygon: &api.Polygon{
+			Rings: []*api.Ring{exterior, hole},
+		},
+		Prefix: "metadata_",
+		Filter: arenas,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefixResp.Objects) != 0 {
+		t.Fatal("expected the hole to exclude metadata_pepsi_center")
+	}
+}
+
+func TestScanBox(t *testing.T) {
+	resp, err := geoDB.ScanBox(context.Background(), &api.ScanBoxRequest{
+		Box: &api.Box{
+			SouthWest: &api.Point{Lat: 39.70, Lon: -105.02},
+			NorthEast: &api.Point{Lat: 39.76, Lon: -104.98},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	regexResp, err := geoDB.ScanRegexBox(context.Background(), &api.ScanRegexBoxRequest{
+		Box: &api.Box{
+			SouthWest: &api.Point{Lat: 39.70, Lon: -105.02},
+			NorthEast: &api.Point{Lat: 39.76, Lon: -104.90},
+		},
+		Regex: "malls_*",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(regexResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	prefix := fmt.Sprintf("antimeridian_ship_%d_", time.Now().UnixNano())
+	var keys []string
+	for i, lon := range []float64{179.9, -179.9, 0} {
+		keys = append(keys, fmt.Sprintf("%s%d", prefix, i))
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  &api.Point{Lat: -17.7, Lon: lon},
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// the box crosses the antimeridian
+	crossing, err := geoDB.ScanPrefixBox(context.Background(), &api.ScanPrefixBoxRequest{
+		Box: &api.Box{
+			SouthWest: &api.Point{Lat: -18, Lon: 179},
+			NorthEast: &api.Point{Lat: -17, Lon: -179},
+		},
+		Prefix: prefix,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(crossing.Objects) != 2 || crossing.Objects[keys[2]] != nil {
+		t.Fatalf("expected the 2 objects next to the antimeridian, got: %v", len(crossing.Objects))
+	}
+	if _, err := geoDB.ScanPrefixBox(context.Background(), &api.ScanPrefixBoxRequest{
+		Box: &api.Box{
+			SouthWest: &api.Point{Lat: -17, Lon: 179},
+			NorthEast: &api.Point{Lat: -18, Lon: -179},
+		},
+		Prefix: prefix,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal("expected inverted box error")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer setMetadataObjects(t)()
+	stadiums := &api.MetadataFilter{
+		Conditions: []*api.MetadataCondition{
+			{
+				Key:      "type",
+				Operator: api.MetadataOperator_Equal,
+				Values:   []string{"stadium"},
+			},
+		},
+	}
+	denver := &api.Box{
+		SouthWest: &api.Point{Lat: 39.70, Lon: -105.02},
+		NorthEast: &api.Point{Lat: 39.76, Lon: -104.90},
+	}
+	resp, err = geoDB.ScanBox(context.Background(), &api.ScanBoxRequest{
+		Box:    denver,
+		Filter: stadiums,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 1 || resp.Objects["metadata_coors"] == nil {
+		t.Fatal("expected metadata_coors")
+	}
+	regexResp, err = geoDB.ScanRegexBox(context.Background(), &api.ScanRegexBoxRequest{
+		Box:    denver,
+		Regex:  "^metadata_",
+		Filter: stadiums,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(regexResp.Objects) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	prefixResp, err := geoDB.ScanPrefixBox(context.Background(), &api.ScanPrefixBoxRequest{
+		Box:    denver,
+		Prefix: "testing_",
+		Filter: stadiums,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(prefixResp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+}
+
+func TestNearest(t *testing.T) {
+	resp, err := geoDB.Nearest(context.Background(), &api.NearestRequest{
+		Point: saintJosephHospital,
+		Limit: 2,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Results) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	if resp.Results[0].Object.Object.Key != "testing_coors" || resp.Results[1].Object.Object.Key != "testing_pepsi_center" {
+		t.Fatal("expected results ordered by distance")
+	}
+	if resp.Results[0].Distance > resp.Results[1].Distance {
+		t.Fatal("expected ascending distances")
+	}
+	resp, err = geoDB.Nearest(context.Background(), &api.NearestRequest{
+		Point:       saintJosephHospital,
+		Limit:       5,
+		MaxDistance: 3000,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Results) != 1 {
+		t.Fatal("expected 1 results")
+	}
+	resp, err = geoDB.Nearest(context.Background(), &api.NearestRequest{
+		Point:  saintJosephHospital,
+		Limit:  5,
+		Prefix: "malls_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Results) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	base := time.Now().Unix() - 100
+	key := fmt.Sprintf("history_courier_%d", time.Now().UnixNano())
+	for i, point := range []*api.Point{coorsField, pepsiCenter, saintJosephHospital, cherryCreekMall} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         key,
+				Point:       point,
+				Radius:      10,
+				UpdatedUnix: base + int64(i*10),
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// an update within the same second as the previous one is kept as well
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:         key,
+			Point:       coorsField,
+			Radius:      10,
+			UpdatedUnix: base + 30,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.GetHistory(context.Background(), &api.GetHistoryRequest{
+		Key:      key,
+		FromUnix: base,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 5 {
+		t.Fatalf("expected 5 results, got %v", len(resp.Objects))
+	}
+	if resp.Objects[0].Point.Lat != coorsField.Lat || resp.Objects[3].Point.Lat != cherryCreekMall.Lat || resp.Objects[4].Point.Lat != coorsField.Lat {
+		t.Fatal("expected history ordered by update time")
+	}
+	resp, err = geoDB.GetHistory(context.Background(), &api.GetHistoryRequest{
+		Key:      key,
+		FromUnix: base + 10,
+		ToUnix:   base + 20,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	resp, err = geoDB.GetHistory(context.Background(), &api.GetHistoryRequest{
+		Key:      key,
+		FromUnix: base,
+		Interval: 15,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 {
+		t.Fatal("expected 2 results")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGetHistoryBefore1970(t *testing.T) {
+	// the config is read concurrently by the background workers, the environment can be changed safely
+	os.Setenv("GEODB_HISTORY_RETENTION", fmt.Sprintf("%dh", 100*365*24))
+	defer os.Unsetenv("GEODB_HISTORY_RETENTION")
+	key := fmt.Sprintf("history_pioneer_%d", time.Now().UnixNano())
+	for _, updated := range []int64{-100, -10, 10} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         key,
+				Point:       coorsField,
+				Radius:      10,
+				UpdatedUnix: updated,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}})
+	resp, err := geoDB.GetHistory(context.Background(), &api.GetHistoryRequest{
+		Key:      key,
+		FromUnix: -50,
+		ToUnix:   50,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 || resp.Objects[0].UpdatedUnix != -10 || resp.Objects[1].UpdatedUnix != 10 {
+		t.Fatalf("expected the updates of -10 and 10, got: %v", resp.Objects)
+	}
+}
+
+func TestGeofence(t *testing.T) {
+	clientID := streamHub.AddGeofenceStreamClient("")
+	defer streamHub.RemoveGeofenceStreamClient(clientID)
+	events := streamHub.GetClientGeofenceStream(clientID)
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{
+		Geofence: &api.Geofence{
+			Key: "downtown",
+			Polygon: &api.Polygon{
+				Rings: []*api.Ring{
+					{
+						Points: []*api.Point{
+							{Lat: 39.73, Lon: -105.02},
+							{Lat: 39.77, Lon: -105.02},
+							{Lat: 39.77, Lon: -104.98},
+							{Lat: 39.73, Lon: -104.98},
+						},
+					},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	fences, err := geoDB.GetGeofences(context.Background(), &api.GetGeofencesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(fences.Geofences) != 1 {
+		t.Fatal("expected 1 geofence")
+	}
+	key := fmt.Sprintf("geofence_courier_%d", time.Now().UnixNano())
+	for _, point := range []*api.Point{cherryCreekMall, coorsField} {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	select {
+	case event := <-events:
+		if event.Geofence.Key != "downtown" || event.Object.Key != key || event.Transition != api.GeofenceTransition_Enter {
+			t.Fatalf("unexpected geofence event: %s", event.String())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected geofence enter event")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteGeofences(context.Background(), &api.DeleteGeofencesRequest{Keys: []string{"downtown"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestGeofenceIndex(t *testing.T) {
+	clientID := streamHub.AddGeofenceStreamClient("")
+	defer streamHub.RemoveGeofenceStreamClient(clientID)
+	events := streamHub.GetClientGeofenceStream(clientID)
+	fence := &api.Geofence{
+		Key: "indexed_fence",
+		Circle: &api.Bound{
+			Center: coorsField,
+			Radius: 500,
+		},
+	}
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{Geofence: fence}); err != nil {
+		t.Fatal(err.Error())
+	}
+	cells := func() int {
+		prefix := []byte("_geodb_geofencecell_")
+		count := 0
+		if err := badgerDB.View(func(txn *badger.Txn) error {
+			iter := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer iter.Close()
+			for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+				if strings.HasSuffix(string(iter.Item().Key()), "_"+fence.Key) {
+					count++
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		return count
+	}
+	if cells() == 0 {
+		t.Fatal("expected the geofence to be indexed")
+	}
+	key := fmt.Sprintf("indexed_fence_courier_%d", time.Now().UnixNano())
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}})
+	next := func() *api.GeofenceEvent {
+		for {
+			select {
+			case event := <-events:
+				if event.Object.Key == key && event.Geofence.Key == fence.Key {
+					return event
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected geofence event")
+			}
+		}
+	}
+	set := func(point *api.Point) {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    key,
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	set(coorsField)
+	if event := next(); event.Transition != api.GeofenceTransition_Enter {
+		t.Fatalf("expected enter event, got: %s", event.Transition.String())
+	}
+	// the geofence moves, its cells around coors field must no longer match while the object leaving it is still reported
+	fence.Circle.Center = pepsiCenter
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{Geofence: fence}); err != nil {
+		t.Fatal(err.Error())
+	}
+	set(pepsiCenter)
+	if event := next(); event.Transition != api.GeofenceTransition_Enter {
+		t.Fatalf("expected enter event, got: %s", event.Transition.String())
+	}
+	set(cherryCreekMall)
+	if event := next(); event.Transition != api.GeofenceTransition_Exit {
+		t.Fatalf("expected exit event, got: %s", event.Transition.String())
+	}
+	if _, err := geoDB.DeleteGeofences(context.Background(), &api.DeleteGeofencesRequest{Keys: []string{fence.Key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if cells() != 0 {
+		t.Fatal("expected the geofence index entries to be removed")
+	}
+}
+
+func TestGeofenceDwell(t *testing.T) {
+	clientID := streamHub.AddGeofenceStreamClient("")
+	defer streamHub.RemoveGeofenceStreamClient(clientID)
+	events := streamHub.GetClientGeofenceStream(clientID)
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{
+		Geofence: &api.Geofence{
+			Key: "coors_field",
+			Circle: &api.Bound{
+				Center: coorsField,
+				Radius: 500,
+			},
+			DwellSeconds: 60,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	now := time.Now().Unix()
+	key := fmt.Sprintf("dwell_courier_%d", time.Now().UnixNano())
+	set := func(point *api.Point, updated int64) {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         key,
+				Point:       point,
+				Radius:      10,
+				UpdatedUnix: updated,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	next := func() *api.GeofenceEvent {
+		for {
+			select {
+			case event := <-events:
+				if event.Object.Key == key {
+					return event
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected geofence event")
+			}
+		}
+	}
+	set(cherryCreekMall, now-300)
+	set(coorsField, now-200)
+	if event := next(); event.Transition != api.GeofenceTransition_Enter {
+		t.Fatalf("expected enter event, got: %s", event.Transition.String())
+	}
+	// the object stops reporting, the dwell is detected by the background check
+	if err := geodb.CheckDwell(badgerDB, streamHub, time.Now()); err != nil {
+		t.Fatal(err.Error())
+	}
+	if event := next(); event.Transition != api.GeofenceTransition_Dwell || event.DwellSeconds < 200 {
+		t.Fatalf("expected dwell event, got: %s", event.String())
+	}
+	set(pepsiCenter, now)
+	if event := next(); event.Transition != api.GeofenceTransition_Exit || event.DwellSeconds != 200 {
+		t.Fatalf("expected exit event after dwelling, got: %s", event.String())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteGeofences(context.Background(), &api.DeleteGeofencesRequest{Keys: []string{"coors_field"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestExpirationUnindex(t *testing.T) {
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{
+		Geofence: &api.Geofence{
+			Key: "expiring_fence",
+			Circle: &api.Bound{
+				Center: coorsField,
+				Radius: 500,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer geoDB.DeleteGeofences(context.Background(), &api.DeleteGeofencesRequest{Keys: []string{"expiring_fence"}})
+	key := fmt.Sprintf("expiring_courier_%d", time.Now().UnixNano())
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:         key,
+			Point:       coorsField,
+			Radius:      10,
+			ExpiresUnix: time.Now().Add(1 * time.Second).Unix(),
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	dwellStates := func() int {
+		prefix := []byte("_geodb_dwell_geofence_" + base64.RawStdEncoding.EncodeToString([]byte(key)) + "_")
+		count := 0
+		if err := badgerDB.View(func(txn *badger.Txn) error {
+			iter := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer iter.Close()
+			for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+				count++
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		return count
+	}
+	if dwellStates() != 1 {
+		t.Fatal("expected 1 dwell state")
+	}
+	time.Sleep(2 * time.Second)
+	if err := geodb.CheckExpirations(badgerDB, streamHub, time.Now()); err != nil {
+		t.Fatal(err.Error())
+	}
+	if dwellStates() != 0 {
+		t.Fatal("expected the dwell state of the expired object to be removed")
+	}
+}
+
+func TestTrackerDwellExpiration(t *testing.T) {
+	clientID := streamHub.AddObjectStreamClient("")
+	defer streamHub.RemoveObjectStreamClient(clientID)
+	objects := streamHub.GetClientObjectStream(clientID)
+	now := time.Now()
+	customer := fmt.Sprintf("dwell_customer_%d", now.UnixNano())
+	driver := fmt.Sprintf("dwell_driver_%d", now.UnixNano())
+	defer geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{customer}})
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  coorsField,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:         driver,
+			Point:       coorsField,
+			Radius:      50,
+			UpdatedUnix: now.Unix() - 300,
+			ExpiresUnix: now.Add(1 * time.Second).Unix(),
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: customer,
+						DwellSeconds:    60,
+					},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the dwell check stores the dwelling tracker event, the expired event must carry it as well
+	if err := geodb.CheckDwell(badgerDB, streamHub, now); err != nil {
+		t.Fatal(err.Error())
+	}
+	time.Sleep(2 * time.Second)
+	if err := geodb.CheckExpirations(badgerDB, streamHub, time.Now()); err != nil {
+		t.Fatal(err.Error())
+	}
+	for {
+		select {
+		case obj := <-objects:
+			if obj.Object.Key != driver || obj.Event != api.ObjectEventType_Expired {
+				continue
+			}
+			if events := obj.TrackerEvents; len(events) != 1 || !events[0].Dwelling {
+				t.Fatalf("expected the expired driver to be dwelling, got: %s", obj.String())
+			}
+			return
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected expired event")
+		}
+	}
+}
+
+func TestReverseTracker(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	driver := fmt.Sprintf("tracker_driver_%d", suffix)
+	customer := fmt.Sprintf("tracker_customer_%d", suffix)
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  cherryCreekMall,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    driver,
+			Point:  coorsField,
+			Radius: 50,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: customer,
+					},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	getDriver := func() *api.ObjectDetail {
+		resp, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{driver}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.Objects[driver]
+	}
+	if events := getDriver().TrackerEvents; len(events) != 1 || events[0].Inside {
+		t.Fatal("expected 1 tracker event outside of the customer")
+	}
+	// the customer walks to the driver, the drivers tracker must follow
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  coorsField,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if events := getDriver().TrackerEvents; len(events) != 1 || !events[0].Inside || events[0].Object.Point.Lat != coorsField.Lat {
+		t.Fatal("expected 1 tracker event inside of the customer")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{customer}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if events := getDriver().TrackerEvents; len(events) != 0 {
+		t.Fatal("expected 0 tracker events after the customer was deleted")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{driver}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestOfflineRouting(t *testing.T) {
+	// broadway runs through a point between coors field and cherry creek mall, the trail connects them directly but only on foot
+	graph := fmt.Sprintf(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "properties": {"name": "Broadway", "highway": "primary"}, "geometry": {"type": "LineString", "coordinates": [[%[1]f, %[2]f], [-104.9875, 39.7300], [%[3]f, %[4]f]]}},
+		{"type": "Feature", "properties": {"name": "Cherry Creek Trail", "highway": "footway"}, "geometry": {"type": "LineString", "coordinates": [[%[1]f, %[2]f], [%[3]f, %[4]f]]}}
+	]}`, coorsField.Lon, coorsField.Lat, cherryCreekMall.Lon, cherryCreekMall.Lat)
+	router, err := maps.NewGraphRouter([]byte(graph))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	offline := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, &maps.Providers{Routing: router}, maps.CacheConfig{DirectionsExpiration: time.Minute}, maps.LimitConfig{}), nil)
+	suffix := time.Now().UnixNano()
+	customer := fmt.Sprintf("routing_customer_%d", suffix)
+	if _, err := offline.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  cherryCreekMall,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	travel := func(mode api.TravelMode) *api.Directions {
+		resp, err := offline.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    fmt.Sprintf("routing_%s_%d", mode.String(), suffix),
+				Point:  coorsField,
+				Radius: 50,
+				Tracking: &api.ObjectTracking{
+					TravelMode: mode,
+					Trackers: []*api.ObjectTracker{
+						{
+							TargetObjectKey: customer,
+							TrackDirections: true,
+							TrackDistance:   true,
+							TrackEta:        true,
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.Object.TrackerEvents) != 1 || resp.Object.TrackerEvents[0].Direction == nil {
+			t.Fatalf("expected directions for %s", mode.String())
+		}
+		return resp.Object.TrackerEvents[0].Direction
+	}
+	driving := travel(api.TravelMode_Driving)
+	walking := travel(api.TravelMode_Walking)
+	if driving.TravelDist <= walking.TravelDist {
+		t.Fatalf("expected driving(%v meters) to take broadway instead of the shorter trail(%v meters)", driving.TravelDist, walking.TravelDist)
+	}
+	if driving.Eta <= 0 || driving.Eta >= walking.Eta {
+		t.Fatalf("expected driving(%v minutes) to be faster than walking(%v minutes)", driving.Eta, walking.Eta)
+	}
+	html, err := base64.StdEncoding.DecodeString(driving.HtmlDirections)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.Contains(string(html), "Broadway") {
+		t.Fatalf("expected directions along broadway: %s", string(html))
+	}
+	// the alley is closest to the origin but can't be driven, reverse street only runs from east to west
+	oneway, err := maps.NewGraphRouter([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "properties": {"name": "Back Alley", "highway": "footway"}, "geometry": {"type": "LineString", "coordinates": [[-105.002, 39.7], [-105.0, 39.7]]}},
+		{"type": "Feature", "properties": {"name": "Reverse Street", "highway": "primary", "oneway": -1}, "geometry": {"type": "LineString", "coordinates": [[-105.0, 39.7], [-104.99, 39.7]]}},
+		{"type": "Feature", "properties": {"name": "Detour Avenue", "highway": "primary"}, "geometry": {"type": "LineString", "coordinates": [[-105.0, 39.7], [-104.995, 39.705], [-104.99, 39.7]]}}
+	]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	route := func(mode api.TravelMode) string {
+		routes, err := oneway.Directions(context.Background(), &api.Point{Lat: 39.7, Lon: -105.0021}, &api.Point{Lat: 39.7, Lon: -104.9899}, mode)
+		if err != nil {
+			t.Fatalf("expected %s directions: %s", mode.String(), err.Error())
+		}
+		return routes[0].Summary
+	}
+	if summary := route(api.TravelMode_Driving); summary != "Detour Avenue" {
+		t.Fatalf("expected driving to start on the road and detour around the reverse oneway, got: %s", summary)
+	}
+	if summary := route(api.TravelMode_Walking); summary != "Back Alley and Reverse Street" {
+		t.Fatalf("expected walking to take the alley and the reverse oneway, got: %s", summary)
+	}
+}
+
+func TestOfflineGeocoding(t *testing.T) {
+	gazetteer, err := maps.NewGazetteer(strings.NewReader(strings.Join([]string{
+		"US\t80202\tDenver\tColorado\tCO\tCity and County of Denver\t031\t\t\t39.7491\t-104.9946\t4",
+		"US\t80246\tGlendale\tColorado\tCO\tArapahoe\t005\t\t\t39.7086\t-104.9338\t4",
+	}, "\n")), 50000)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	offline := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, &maps.Providers{Geocoding: gazetteer}, maps.CacheConfig{DirectionsExpiration: time.Minute}, maps.LimitConfig{}), nil)
+	suffix := time.Now().UnixNano()
+	for point, zip := range map[*api.Point]string{coorsField: "80202", cherryCreekMall: "80246"} {
+		resp, err := offline.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:        fmt.Sprintf("geocoding_%s_%d", zip, suffix),
+				Point:      point,
+				Radius:     50,
+				GetAddress: true,
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if resp.Object.Address == nil || resp.Object.Address.Zip != zip || resp.Object.Address.State != "Colorado" || resp.Object.Address.Country != "US" {
+			t.Fatalf("expected an address in %s: %s", zip, helpers.PrettyJson(resp.Object))
+		}
+	}
+	// salt lake city is too far away from every place
+	if _, err := gazetteer.ReverseGeocode(context.Background(), &api.Point{Lat: 40.7608, Lon: -111.8910}); err != maps.ErrNoResults {
+		t.Fatalf("expected no results beyond the max distance: %v", err)
+	}
+	// boulder is further away than the first rings of the grid but within the max distance
+	address, err := gazetteer.ReverseGeocode(context.Background(), &api.Point{Lat: 40.0150, Lon: -105.2705})
+	if err != nil || address.Zip != "80202" {
+		t.Fatalf("expected the address of the closest place within the max distance: %v %s", err, helpers.PrettyJson(address))
+	}
+	// a degree of longitude is much shorter than a degree of latitude this far north
+	arctic, err := maps.NewGazetteer(strings.NewReader(strings.Join([]string{
+		"NO\t9019\tTromso North\tTroms\t54\t\t\t\t\t69.675\t18.955\t4",
+		"NO\t9008\tTromso East\tTroms\t54\t\t\t\t\t69.655\t18.995\t4",
+	}, "\n")), 50000)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	address, err = arctic.ReverseGeocode(context.Background(), &api.Point{Lat: 69.655, Lon: 18.955})
+	if err != nil || address.Zip != "9008" {
+		t.Fatalf("expected the address of the closest place: %v %s", err, helpers.PrettyJson(address))
+	}
+}
+
+func TestOfflineTimezone(t *testing.T) {
+	boundaries, err := maps.NewTimezoneBoundaries([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "properties": {"tzid": "America/Denver"}, "geometry": {"type": "Polygon", "coordinates": [[[-109.05, 37], [-102.05, 37], [-102.05, 41], [-109.05, 41], [-109.05, 37]]]}}
+	]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	offline := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, &maps.Providers{Timezones: boundaries}, maps.CacheConfig{DirectionsExpiration: time.Minute}, maps.LimitConfig{}), nil)
+	suffix := time.Now().UnixNano()
+	type expectation struct {
+		point   *api.Point
+		updated time.Time
+		zone    string
+		offset  int32
+		dst     bool
+	}
+	for i, expected := range []expectation{
+		{point: coorsField, updated: time.Date(2020, time.January, 15, 12, 0, 0, 0, time.UTC), zone: "America/Denver", offset: -7 * 3600},
+		{point: coorsField, updated: time.Date(2020, time.July, 15, 12, 0, 0, 0, time.UTC), zone: "America/Denver", offset: -6 * 3600, dst: true},
+		// outside of every boundary
+		{point: &api.Point{Lat: 30, Lon: -45}, updated: time.Date(2020, time.July, 15, 12, 0, 0, 0, time.UTC), zone: "Etc/GMT+3", offset: -3 * 3600},
+	} {
+		resp, err := offline.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         fmt.Sprintf("timezone_%d_%d", i, suffix),
+				Point:       expected.point,
+				Radius:      50,
+				GetTimezone: true,
+				UpdatedUnix: expected.updated.Unix(),
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if resp.Object.Timezone != expected.zone || resp.Object.UtcOffset != expected.offset || resp.Object.Dst != expected.dst {
+			t.Fatalf("expected %s(offset: %v dst: %v): %s", expected.zone, expected.offset, expected.dst, helpers.PrettyJson(resp.Object))
+		}
+	}
+}
+
+func TestMapsProvider(t *testing.T) {
+	fake := maps.NewFake().
+		ScriptDirections(maps.FakeRoute{Meters: 4200, Seconds: 600, Instructions: []string{"Head north on <b>Speer Blvd</b>", "Turn right onto <b>Colfax Ave</b>"}}).
+		ScriptReverseGeocode(&api.Address{Address: "1000 Chopper Cir, Denver, CO 80204, USA", City: "Denver", State: "Colorado", Zip: "80204", Country: "United States"}).
+		ScriptTimezone("America/Denver").
+		ScriptGeocode(pepsiCenter)
+	scripted := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, fake, maps.CacheConfig{DirectionsExpiration: time.Minute}, maps.LimitConfig{}), nil)
+	// boulder is far enough from the points of the other tests to miss the maps cache
+	pearlStreet := &api.Point{Lat: 40.01806, Lon: -105.27806}
+	flatirons := &api.Point{Lat: 39.98833, Lon: -105.29306}
+	university := &api.Point{Lat: 40.00750, Lon: -105.26583}
+	suffix := time.Now().UnixNano()
+	customer := fmt.Sprintf("maps_customer_%d", suffix)
+	if _, err := scripted.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  flatirons,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := scripted.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    fmt.Sprintf("maps_driver_%d", suffix),
+			Point:  pearlStreet,
+			Radius: 50,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: customer,
+						TrackDirections: true,
+						TrackDistance:   true,
+						TrackEta:        true,
+					},
+				},
+			},
+			GetAddress:  true,
+			GetTimezone: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Address == nil || resp.Object.Address.Zip != "80204" {
+		t.Fatalf("expected the scripted address: %s", helpers.PrettyJson(resp.Object))
+	}
+	if resp.Object.Timezone != "America/Denver" {
+		t.Fatalf("expected the scripted timezone: %s", helpers.PrettyJson(resp.Object))
+	}
+	if len(resp.Object.TrackerEvents) != 1 || resp.Object.TrackerEvents[0].Direction == nil {
+		t.Fatalf("expected directions to the customer: %s", helpers.PrettyJson(resp.Object))
+	}
+	direction := resp.Object.TrackerEvents[0].Direction
+	if direction.Eta != 10 || direction.TravelDist != 4200 {
+		t.Fatalf("expected the scripted eta & distance: %s", helpers.PrettyJson(direction))
+	}
+	html, err := base64.StdEncoding.DecodeString(direction.HtmlDirections)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.Contains(string(html), "Colfax Ave") {
+		t.Fatalf("expected the scripted instructions: %s", string(html))
+	}
+	point, err := scripted.GetPoint(context.Background(), &api.GetPointRequest{Address: "1000 Chopper Cir, Denver, CO"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if point.Point.Lat != pepsiCenter.Lat || point.Point.Lon != pepsiCenter.Lon {
+		t.Fatalf("expected the scripted point: %s", helpers.PrettyJson(point))
+	}
+	// failing directions degrade to the straight line to the customer
+	fake.ScriptError(maps.FakeDirections, errors.New("quota exceeded"))
+	resp, err = scripted.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    fmt.Sprintf("maps_driver_%d", suffix),
+			Point:  university,
+			Radius: 50,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: customer,
+						TrackEta:        true,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Object.TrackerEvents) != 1 || resp.Object.TrackerEvents[0].Direction == nil {
+		t.Fatalf("expected straight line directions to the customer: %s", helpers.PrettyJson(resp.Object))
+	}
+	if direction := resp.Object.TrackerEvents[0].Direction; direction.Eta < 1 || direction.Eta > 5 {
+		t.Fatalf("expected the straight line distance & eta: %s", helpers.PrettyJson(direction))
+	}
+	if fake.Calls(maps.FakeDirections) != 2 || fake.Calls(maps.FakeReverseGeocode) != 1 || fake.Calls(maps.FakeTimezone) != 1 {
+		t.Fatalf("expected every request to reach the provider once: %v directions, %v addresses, %v timezones", fake.Calls(maps.FakeDirections), fake.Calls(maps.FakeReverseGeocode), fake.Calls(maps.FakeTimezone))
+	}
+	// moving the customer refreshes the tracker of the driver before the write returns in sync enrichment mode
+	if _, err := scripted.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    customer,
+			Point:  pearlStreet,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	driver, err := scripted.Get(context.Background(), &api.GetRequest{Keys: []string{fmt.Sprintf("maps_driver_%d", suffix)}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, obj := range driver.Objects {
+		if len(obj.TrackerEvents) != 1 || obj.TrackerEvents[0].GetObject().GetPoint().GetLat() != pearlStreet.Lat || obj.TrackerEvents[0].Direction == nil {
+			t.Fatalf("expected the refreshed tracker event: %s", helpers.PrettyJson(obj))
+		}
+	}
+	if len(driver.Objects) != 1 {
+		t.Fatalf("expected the driver: %s", helpers.PrettyJson(driver))
+	}
+}
+
+func TestMapsCache(t *testing.T) {
+	fake := maps.NewFake().ScriptReverseGeocode(&api.Address{Address: "Pearl St, Boulder, CO 80302, USA", Zip: "80302"})
+	cached := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, fake, maps.CacheConfig{Precision: 7}, maps.LimitConfig{}), nil)
+	if _, err := cached.PurgeMapsCache(context.Background(), &api.PurgeMapsCacheRequest{}); err != nil {
+		t.Fatal(err.Error())
+	}
+	stats := func() map[api.MapsCacheType]*api.MapsCacheStats {
+		resp, err := cached.GetMapsCacheStats(context.Background(), &api.GetMapsCacheStatsRequest{})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		caches := map[api.MapsCacheType]*api.MapsCacheStats{}
+		for _, cache := range resp.Caches {
+			caches[cache.Type] = cache
+		}
+		return caches
+	}
+	suffix := time.Now().UnixNano()
+	// the fixes are about a meter apart and share a geohash of length 7
+	for i, point := range []*api.Point{{Lat: 40.018060, Lon: -105.278060}, {Lat: 40.018065, Lon: -105.278070}, {Lat: 40.5, Lon: -105.5}} {
+		if _, err := cached.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:        fmt.Sprintf("cache_%d_%d", i, suffix),
+				Point:      point,
+				Radius:     50,
+				GetAddress: true,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+		if i == 1 {
+			if address := stats()[api.MapsCacheType_AddressCache]; address.Entries != 1 || address.Hits != 1 || address.Misses != 1 || fake.Calls(maps.FakeReverseGeocode) != 1 {
+				t.Fatalf("expected the second fix to hit the cache: %s", helpers.PrettyJson(address))
+			}
+			bound, err := cached.BoundMapsCache(context.Background(), &api.BoundMapsCacheRequest{Type: api.MapsCacheType_AddressCache, MaxEntries: 1})
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if bound.Evicted != 0 {
+				t.Fatalf("expected no evictions, got %v", bound.Evicted)
+			}
+		}
+	}
+	if address := stats()[api.MapsCacheType_AddressCache]; address.Entries != 1 || address.MaxEntries != 1 {
+		t.Fatalf("expected the oldest address to be evicted: %s", helpers.PrettyJson(address))
+	}
+	purged, err := cached.PurgeMapsCache(context.Background(), &api.PurgeMapsCacheRequest{Types: []api.MapsCacheType{api.MapsCacheType_AddressCache}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if purged.Purged != 1 || stats()[api.MapsCacheType_AddressCache].Entries != 0 {
+		t.Fatalf("expected 1 purged address, got %v", purged.Purged)
+	}
+	if _, err := cached.PurgeMapsCache(context.Background(), &api.PurgeMapsCacheRequest{Types: []api.MapsCacheType{10}}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected an unknown cache to be rejected, got %v", err)
+	}
+	// a coarse timezone precision is raised to the address precision, so points a kilometer apart near a zone border don't share a zone
+	zones := maps.NewClient(badgerDB, maps.NewFake().ScriptTimezone("America/Denver", "America/Chicago"), maps.CacheConfig{Precision: 8, TimezonePrecision: 4}, maps.LimitConfig{})
+	west, err := zones.GetTimezone(&api.Point{Lat: 41.00010, Lon: -102.05200})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	east, err := zones.GetTimezone(&api.Point{Lat: 41.00010, Lon: -102.04000})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if west != "America/Denver" || east != "America/Chicago" {
+		t.Fatalf("expected each side of the border to get its own zone: %s %s", west, east)
+	}
+}
+
+func TestMapsLimits(t *testing.T) {
+	pearlStreet := &api.Point{Lat: 40.01806, Lon: -105.27806}
+	flatirons := &api.Point{Lat: 39.98833, Lon: -105.29306}
+	// every request misses the cache so it reaches the limits
+	points := func(i int) *api.Point {
+		return &api.Point{Lat: 40.1 + float64(i)*0.01, Lon: -105.1}
+	}
+	suffix := time.Now().UnixNano()
+	address := func(i int) string {
+		return fmt.Sprintf("%d Chopper Cir, Denver, CO", suffix+int64(i))
+	}
+	limited := maps.NewClient(badgerDB, maps.NewFake().ScriptGeocode(pepsiCenter), maps.CacheConfig{}, maps.LimitConfig{QPS: 2})
+	for i := 0; i < 2; i++ {
+		if _, err := limited.GetCoordinates(address(i)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if _, err := limited.GetCoordinates(address(2)); err != maps.ErrRateLimited {
+		t.Fatalf("expected the burst to exhaust the qps limit: %v", err)
+	}
+	time.Sleep(600 * time.Millisecond)
+	if _, err := limited.GetCoordinates(address(3)); err != nil {
+		t.Fatalf("expected the qps limit to refill: %v", err)
+	}
+	// a qps below 1 lets one request through every 1/qps seconds
+	slow := maps.NewClient(badgerDB, maps.NewFake().ScriptGeocode(pepsiCenter), maps.CacheConfig{}, maps.LimitConfig{QPS: 0.5})
+	if _, err := slow.GetCoordinates(address(20)); err != nil {
+		t.Fatalf("expected the first request to pass a qps limit below 1: %v", err)
+	}
+	if _, err := slow.GetCoordinates(address(21)); err != maps.ErrRateLimited {
+		t.Fatalf("expected the qps limit to be exhausted: %v", err)
+	}
+	time.Sleep(2100 * time.Millisecond)
+	if _, err := slow.GetCoordinates(address(22)); err != nil {
+		t.Fatalf("expected the qps limit to refill after 2s: %v", err)
+	}
+
+	fake := maps.NewFake().ScriptGeocode(pepsiCenter)
+	budgeted := maps.NewClient(badgerDB, fake, maps.CacheConfig{}, maps.LimitConfig{DailyBudget: 1})
+	// the budget of today may be used up by a previous run
+	if _, err := budgeted.GetCoordinates(address(4)); err != nil && err != maps.ErrBudgetExceeded {
+		t.Fatal(err.Error())
+	}
+	if _, err := budgeted.GetCoordinates(address(5)); err != maps.ErrBudgetExceeded {
+		t.Fatalf("expected the daily budget to be exceeded: %v", err)
+	}
+	if fake.Calls(maps.FakeGeocode) > 1 {
+		t.Fatalf("expected at most one request within the budget: %v", fake.Calls(maps.FakeGeocode))
+	}
+	// the budget is stored in badger and survives restarts
+	restarted := maps.NewClient(badgerDB, fake, maps.CacheConfig{}, maps.LimitConfig{DailyBudget: 1})
+	if _, err := restarted.GetCoordinates(address(6)); err != maps.ErrBudgetExceeded {
+		t.Fatalf("expected the stored daily budget to be exceeded: %v", err)
+	}
+	if _, _, _, err := restarted.TravelDetail(context.Background(), pearlStreet, flatirons, api.TravelMode_Walking); err != nil {
+		t.Fatalf("expected directions to degrade to a straight line: %v", err)
+	}
+
+	fake = maps.NewFake().
+		ScriptError(maps.FakeDirections, errors.New("backend error")).
+		ScriptError(maps.FakeDirections, errors.New("backend error")).
+		ScriptDirections(maps.FakeRoute{Meters: 4200, Seconds: 600, Instructions: []string{"Head south on <b>Broadway</b>"}})
+	breaker := maps.NewClient(badgerDB, fake, maps.CacheConfig{}, maps.LimitConfig{BreakerFailures: 2, BreakerCooldown: 500 * time.Millisecond})
+	for i := 0; i < 3; i++ {
+		html, eta, dist, err := breaker.TravelDetail(context.Background(), pearlStreet, points(10+i), api.TravelMode_Walking)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		decoded, _ := base64.StdEncoding.DecodeString(html)
+		if !strings.Contains(string(decoded), "Straight line") || dist == 0 || eta == 0 {
+			t.Fatalf("expected straight line directions(eta: %v dist: %v): %s", eta, dist, string(decoded))
+		}
+	}
+	if fake.Calls(maps.FakeDirections) != 2 {
+		t.Fatalf("expected the open circuit breaker to stop requests: %v", fake.Calls(maps.FakeDirections))
+	}
+	if _, err := breaker.Directions(context.Background(), pearlStreet, points(13), api.TravelMode_Walking); err != maps.ErrCircuitOpen {
+		t.Fatalf("expected the circuit breaker to be open: %v", err)
+	}
+	time.Sleep(600 * time.Millisecond)
+	_, eta, dist, err := breaker.TravelDetail(context.Background(), pearlStreet, points(14), api.TravelMode_Walking)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if eta != 10 || dist != 4200 || fake.Calls(maps.FakeDirections) != 3 {
+		t.Fatalf("expected the probe to close the circuit breaker(eta: %v dist: %v calls: %v)", eta, dist, fake.Calls(maps.FakeDirections))
+	}
+}
+
+func TestAsyncEnrichment(t *testing.T) {
+	config.Config.Set("GEODB_ENRICHMENT_MODE", "async")
+	defer config.Config.Set("GEODB_ENRICHMENT_MODE", "sync")
+	fake := maps.NewFake().
+		ScriptDirections(maps.FakeRoute{Meters: 15000, Seconds: 1200, Instructions: []string{"Head east on <b>6th Ave</b>"}}).
+		ScriptReverseGeocode(&api.Address{Address: "Washington Ave, Golden, CO 80401, USA", City: "Golden", Zip: "80401"}).
+		ScriptTimezone("America/Denver")
+	async := services.NewGeoDB(badgerDB, streamHub, maps.NewClient(badgerDB, fake, maps.CacheConfig{DirectionsExpiration: time.Minute}, maps.LimitConfig{}), nil)
+	// golden is far enough from the points of the other tests to miss the maps cache
+	golden := &api.Point{Lat: 39.75554, Lon: -105.22110}
+	lakewood := &api.Point{Lat: 39.70472, Lon: -105.08139}
+	suffix := time.Now().UnixNano()
+	target := fmt.Sprintf("enrichment_target_%d", suffix)
+	key := fmt.Sprintf("enrichment_object_%d", suffix)
+	if _, err := async.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    target,
+			Point:  lakewood,
+			Radius: 50,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	clientID := streamHub.AddObjectStreamClient("")
+	defer streamHub.RemoveObjectStreamClient(clientID)
+	updates := streamHub.GetClientObjectStream(clientID)
+	next := func() *api.ObjectDetail {
+		for {
+			select {
+			case obj := <-updates:
+				if obj.GetObject().GetKey() == key {
+					return obj
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected streamed object")
+				return nil
+			}
+		}
+	}
+	resp, err := async.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    key,
+			Point:  golden,
+			Radius: 50,
+			Tracking: &api.ObjectTracking{
+				Trackers: []*api.ObjectTracker{
+					{
+						TargetObjectKey: target,
+						TrackEta:        true,
+						TrackDistance:   true,
+					},
+				},
+			},
+			GetAddress:  true,
+			GetTimezone: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Address != nil || resp.Object.Timezone != "" || len(resp.Object.TrackerEvents) != 1 || resp.Object.TrackerEvents[0].Direction != nil {
+		t.Fatalf("expected the raw position without maps lookups: %s", helpers.PrettyJson(resp.Object))
+	}
+	if resp.Object.TrackerEvents[0].Distance == 0 {
+		t.Fatalf("expected the tracker distance without maps lookups: %s", helpers.PrettyJson(resp.Object))
+	}
+	if obj := next(); obj.Event != api.ObjectEventType_Set || obj.Address != nil {
+		t.Fatalf("expected the raw set event first: %s", helpers.PrettyJson(obj))
+	}
+	enriched := next()
+	if enriched.Event != api.ObjectEventType_Enriched || enriched.Version != resp.Object.Version {
+		t.Fatalf("expected an enriched event of the same version: %s", helpers.PrettyJson(enriched))
+	}
+	if enriched.GetAddress().GetZip() != "80401" || enriched.Timezone != "America/Denver" || enriched.UtcOffset == 0 {
+		t.Fatalf("expected the scripted address & timezone: %s", helpers.PrettyJson(enriched))
+	}
+	if len(enriched.TrackerEvents) != 1 || enriched.TrackerEvents[0].GetDirection().GetEta() != 20 || enriched.TrackerEvents[0].GetDirection().GetTravelDist() != 15000 {
+		t.Fatalf("expected the scripted directions: %s", helpers.PrettyJson(enriched))
+	}
+	got, err := async.Get(context.Background(), &api.GetRequest{Keys: []string{key}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if stored := got.Objects[key]; stored.GetAddress().GetZip() != "80401" || stored.Version != resp.Object.Version || stored.Event != api.ObjectEventType_Enriched || stored.Sequence != enriched.Sequence {
+		t.Fatalf("expected the stored object detail to be patched: %s", helpers.PrettyJson(stored))
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	config.Config.Set("GEODB_WEBHOOK_MAX_ATTEMPTS", 2)
+	config.Config.Set("GEODB_WEBHOOK_BACKOFF", "10ms")
+	received := make(chan *api.WebhookPayload, 10)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil || r.Header.Get(webhook.SignatureHeader) != "sha256="+webhook.Sign("secret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		payload := &api.WebhookPayload{}
+		if err := jsonpb.UnmarshalString(string(body), payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- payload
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		webhook.NewDispatcher(badgerDB, streamHub, nil).Start(ctx)
+		close(stopped)
+	}()
+	defer func() {
+		cancel()
+		<-stopped
+	}()
+	for streamHub.GetClientGeofenceStream(webhook.ClientID) == nil {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, hook := range []*api.Webhook{
+		{
+			Id:         "courier_updates",
+			Url:        ok.URL,
+			Prefix:     "webhook_courier_",
+			EventTypes: []api.WebhookEventType{api.WebhookEventType_ObjectUpdated},
+			Secret:     "secret",
+		},
+		{
+			Id:     "broken",
+			Url:    failing.URL,
+			Prefix: "webhook_courier_",
+		},
+	} {
+		if _, err := geoDB.SetWebhook(context.Background(), &api.SetWebhookRequest{Webhook: hook}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	hooks, err := geoDB.GetWebhooks(context.Background(), &api.GetWebhooksRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if hooks.Webhooks["courier_updates"] == nil || hooks.Webhooks["courier_updates"].Secret != "" {
+		t.Fatalf("expected webhook with redacted secret: %s", helpers.PrettyJson(hooks))
+	}
+	key := fmt.Sprintf("webhook_courier_%d", time.Now().UnixNano())
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    key,
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	select {
+	case payload := <-received:
+		if payload.EventType != api.WebhookEventType_ObjectUpdated || payload.Object.Object.Key != key {
+			t.Fatalf("unexpected webhook payload: %s", payload.String())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected webhook delivery")
+	}
+	var deadLetters []*api.WebhookDelivery
+	for deadline := time.Now().Add(5 * time.Second); len(deadLetters) == 0 && time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		resp, err := geoDB.GetDeadLetters(context.Background(), &api.GetDeadLettersRequest{WebhookId: "broken"})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		deadLetters = resp.Deliveries
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Attempts != 2 || deadLetters[0].Payload.Object.Object.Key != key {
+		t.Fatal("expected 1 dead letter after 2 attempts")
+	}
+	if _, err := geoDB.DeleteWebhooks(context.Background(), &api.DeleteWebhooksRequest{Ids: []string{"courier_updates", "broken"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	hooks, err = geoDB.GetWebhooks(context.Background(), &api.GetWebhooksRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(hooks.Webhooks) != 0 {
+		t.Fatalf("expected deleted webhooks to leave the cache: %s", helpers.PrettyJson(hooks))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamResume(t *testing.T) {
+	prefix := fmt.Sprintf("resume_courier_%d_", time.Now().UnixNano())
+	set := func(i int) *api.ObjectDetail {
+		resp, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    fmt.Sprintf("%s%d", prefix, i),
+				Point:  coorsField,
+				Radius: 10,
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return resp.Object
+	}
+	first := set(0)
+	stored, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{first.Object.Key}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if stored.Objects[first.Object.Key].GetSequence() != first.Sequence {
+		t.Fatalf("expected the stored object to carry its change log sequence: %s", helpers.PrettyJson(stored))
+	}
+	set(1)
+	set(2)
+	ctx, cancel := context.WithCancel(context.Background())
+	ss := &prefixStream{
+		ctx:     ctx,
+		objects: make(chan *api.ObjectDetail, 10),
+	}
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- geoDB.StreamPrefix(&api.StreamPrefixRequest{
+			Prefix:       prefix,
+			FromSequence: first.Sequence,
+		}, ss)
+	}()
+	next := func() *api.ObjectDetail {
+		select {
+		case obj := <-ss.objects:
+			return obj
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected streamed object")
+		}
+		return nil
+	}
+	for i := 0; i < 3; i++ {
+		if obj := next(); obj.Sequence != first.Sequence+uint64(i) || obj.Object.Key != fmt.Sprintf("%s%d", prefix, i) {
+			t.Fatalf("expected replayed object %d in order, got: %s", i, obj.String())
+		}
+	}
+	live := set(3)
+	if obj := next(); obj.Sequence != live.Sequence {
+		t.Fatalf("expected live object, got: %s", obj.String())
+	}
+	cancel()
+	if err := <-stopped; err != nil {
+		t.Fatal(err.Error())
+	}
+	config.Config.Set("GEODB_CHANGELOG_SIZE", 2)
+	defer config.Config.Set("GEODB_CHANGELOG_SIZE", 100000)
+	set(4)
+	err = geoDB.StreamPrefix(&api.StreamPrefixRequest{
+		Prefix:       prefix,
+		FromSequence: first.Sequence,
+	}, &prefixStream{ctx: context.Background(), objects: make(chan *api.ObjectDetail, 10)})
+	if status.Code(err) != codes.OutOfRange {
+		t.Fatal("expected truncated change log error")
+	}
+	var keys []string
+	for i := 0; i < 5; i++ {
+		keys = append(keys, fmt.Sprintf("%s%d", prefix, i))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	prefix := fmt.Sprintf("events_courier_%d_", time.Now().UnixNano())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ss := &prefixStream{
+		ctx:     ctx,
+		objects: make(chan *api.ObjectDetail, 10),
+	}
+	go geoDB.StreamPrefix(&api.StreamPrefixRequest{
+		ClientId: prefix,
+		Prefix:   prefix,
+	}, ss)
+	next := func() *api.ObjectDetail {
+		select {
+		case obj := <-ss.objects:
+			return obj
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected streamed object")
+		}
+		return nil
+	}
+	for streamHub.GetClientObjectStream(prefix) == nil {
+		time.Sleep(10 * time.Millisecond)
+	}
+	deleted := prefix + "deleted"
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:    deleted,
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if obj := next(); obj.Event != api.ObjectEventType_Set || obj.Object.Key != deleted {
+		t.Fatalf("expected set event, got: %s", obj.String())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{deleted}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if obj := next(); obj.Event != api.ObjectEventType_Deleted || obj.Object.Key != deleted {
+		t.Fatalf("expected deleted event, got: %s", obj.String())
+	}
+	expired := prefix + "expired"
+	if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+		Object: &api.Object{
+			Key:         expired,
+			Point:       coorsField,
+			Radius:      10,
+			ExpiresUnix: time.Now().Add(1 * time.Second).Unix(),
+		},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if obj := next(); obj.Event != api.ObjectEventType_Set {
+		t.Fatalf("expected set event, got: %s", obj.String())
+	}
+	if err := geodb.CheckExpirations(badgerDB, streamHub, time.Now()); err != nil {
+		t.Fatal(err.Error())
+	}
+	time.Sleep(2 * time.Second)
+	if err := geodb.CheckExpirations(badgerDB, streamHub, time.Now()); err != nil {
+		t.Fatal(err.Error())
+	}
+	if obj := next(); obj.Event != api.ObjectEventType_Expired || obj.Object.Key != expired {
+		t.Fatalf("expected expired event, got: %s", obj.String())
+	}
+}
+
+func TestStreamBound(t *testing.T) {
+	clientID := fmt.Sprintf("bound_client_%d", time.Now().UnixNano())
+	metadata := map[string]string{"fleet": clientID}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ss := &boundStream{
+		ctx:       ctx,
+		responses: make(chan *api.StreamBoundResponse, 10),
+	}
+	go geoDB.StreamBound(&api.StreamBoundRequest{
+		ClientId: clientID,
+		Circle: &api.Bound{
+			Center: coorsField,
+			Radius: 2000,
+		},
+		Filter: &api.MetadataFilter{
+			Conditions: []*api.MetadataCondition{
+				{
+					Key:      "fleet",
+					Operator: api.MetadataOperator_Equal,
+					Values:   []string{clientID},
+				},
+			},
+		},
+	}, ss)
+	for streamHub.GetClientObjectStream(clientID) == nil {
+		time.Sleep(10 * time.Millisecond)
+	}
+	set := func(point *api.Point) {
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:      clientID,
+				Point:    point,
+				Radius:   10,
+				Metadata: metadata,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	next := func() *api.StreamBoundResponse {
+		select {
+		case resp := <-ss.responses:
+			return resp
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected streamed object")
+		}
+		return nil
+	}
+	set(pepsiCenter)
+	if resp := next(); resp.Left || resp.Object.Object.Key != clientID {
+		t.Fatalf("expected object inside the area, got: %s", resp.String())
+	}
+	set(cherryCreekMall)
+	if resp := next(); !resp.Left {
+		t.Fatalf("expected object to leave the area, got: %s", resp.String())
+	}
+	// updates outside the area aren't sent once the object left
+	set(cherryCreekMall)
+	set(coorsField)
+	if resp := next(); resp.Left || resp.Object.Object.Point.Lat != coorsField.Lat {
+		t.Fatalf("expected object back inside the area, got: %s", resp.String())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{clientID}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp := next(); resp.Event != api.ObjectEventType_Deleted {
+		t.Fatalf("expected deleted event, got: %s", resp.String())
+	}
+}
+
+func TestPagination(t *testing.T) {
+	prefix := fmt.Sprintf("paged_courier_%d_", time.Now().UnixNano())
+	points := []*api.Point{coorsField, pepsiCenter, saintJosephHospital, coorsField, pepsiCenter}
+	var keys []string
+	for i, point := range points {
+		keys = append(keys, fmt.Sprintf("%s%d", prefix, i))
+		if _, err := geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  point,
+				Radius: 10,
+			},
+		}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	pages := func(page func(token string) (int, string, error)) int {
+		total, token, calls := 0, "", 0
+		for {
+			count, next, err := page(token)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if count > 2 {
+				t.Fatalf("expected at most 2 results per page, got: %d", count)
+			}
+			total += count
+			calls++
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		if calls != 3 {
+			t.Fatalf("expected 3 pages, got: %d", calls)
+		}
+		return total
+	}
+	seen := map[string]struct{}{}
+	if total := pages(func(token string) (int, string, error) {
+		resp, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{
+			Prefix:    prefix,
+			PageSize:  2,
+			PageToken: token,
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		for key := range resp.Objects {
+			seen[key] = struct{}{}
+		}
+		return len(resp.Objects), resp.NextPageToken, nil
+	}); total != 5 || len(seen) != 5 {
+		t.Fatalf("expected 5 unique objects, got: %d", len(seen))
+	}
+	seen = map[string]struct{}{}
+	if total := pages(func(token string) (int, string, error) {
+		resp, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+			Bound: &api.Bound{
+				Center: coorsField,
+				Radius: 5000,
+			},
+			Prefix:    prefix,
+			PageSize:  2,
+			PageToken: token,
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		for key := range resp.Objects {
+			seen[key] = struct{}{}
+		}
+		return len(resp.Objects), resp.NextPageToken, nil
+	}); total != 5 || len(seen) != 5 {
+		t.Fatalf("expected 5 unique scanned objects, got: %d", len(seen))
+	}
+	if total := pages(func(token string) (int, string, error) {
+		resp, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+			Prefix:    prefix,
+			PageSize:  2,
+			PageToken: token,
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		return len(resp.Keys), resp.NextPageToken, nil
+	}); total != 5 {
+		t.Fatalf("expected 5 keys, got: %d", total)
+	}
+	ss := &resultStream{}
+	if err := geoDB.GetPrefixStream(&api.GetPrefixRequest{Prefix: prefix}, ss); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(ss.results) != 5 {
+		t.Fatalf("expected 5 streamed objects, got: %d", len(ss.results))
+	}
+	resumed := &resultStream{}
+	if err := geoDB.GetPrefixStream(&api.GetPrefixRequest{Prefix: prefix, PageToken: ss.results[1].PageToken}, resumed); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resumed.results) != 3 || resumed.results[0].Object.Object.Key != ss.results[2].Object.Object.Key {
+		t.Fatal("expected the stream to resume after the page token")
+	}
+	if _, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{Prefix: prefix, PageToken: "!"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal("expected invalid page token error")
+	}
+	scanned, err := geoDB.ScanPrefixBound(context.Background(), &api.ScanPrefixBoundRequest{
+		Bound:    &api.Bound{Center: coorsField, Radius: 5000},
+		Prefix:   prefix,
+		PageSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// scans walk the spatial index, so their tokens don't resume key listings
+	if _, err := geoDB.GetPrefix(context.Background(), &api.GetPrefixRequest{Prefix: prefix, PageToken: scanned.NextPageToken}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal("expected page token of a different listing error")
+	}
+	if err := (&api.GetPrefixRequest{Prefix: prefix, PageSize: -1}).Validate(); err == nil {
+		t.Fatal("expected negative page size error")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestSetPrecondition(t *testing.T) {
+	key := fmt.Sprintf("versioned_courier_%d", time.Now().UnixNano())
+	now := time.Now().Unix()
+	set := func(updated int64, precondition *api.Precondition) (*api.SetResponse, error) {
+		return geoDB.Set(context.Background(), &api.SetRequest{
+			Object: &api.Object{
+				Key:         key,
+				Point:       coorsField,
+				Radius:      10,
+				UpdatedUnix: updated,
+			},
+			Precondition: precondition,
+		})
+	}
+	resp, err := set(now, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Version != 1 {
+		t.Fatalf("expected version 1, got: %d", resp.Object.Version)
+	}
+	resp, err = set(now+1, &api.Precondition{ExpectedVersion: 1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Object.Version != 2 {
+		t.Fatalf("expected version 2, got: %d", resp.Object.Version)
+	}
+	if _, err := set(now+2, &api.Precondition{ExpectedVersion: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal("expected version conflict")
+	}
+	if _, err := set(now, &api.Precondition{IfNewerThanUpdatedUnix: true}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal("expected stale write to be rejected")
+	}
+	config.Config.Set("GEODB_STALE_WRITE_POLICY", "skip")
+	defer config.Config.Set("GEODB_STALE_WRITE_POLICY", "reject")
+	resp, err = set(now, &api.Precondition{IfNewerThanUpdatedUnix: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !resp.Skipped || resp.Object.Version != 2 || resp.Object.Object.UpdatedUnix != now+1 {
+		t.Fatalf("expected stale write to be skipped, got: %s", resp.String())
+	}
+	resp, err = set(now+2, &api.Precondition{IfNewerThanUpdatedUnix: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.Skipped || resp.Object.Version != 3 {
+		t.Fatalf("expected newer write to be applied, got: %s", resp.String())
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{key}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestBatchSet(t *testing.T) {
+	driver := fmt.Sprintf("batch_driver_%d", time.Now().UnixNano())
+	order := fmt.Sprintf("batch_order_%d", time.Now().UnixNano())
+	resp, err := geoDB.BatchSet(context.Background(), &api.BatchSetRequest{
+		Objects: []*api.Object{
+			{
+				Key:      driver,
+				Point:    coorsField,
+				Radius:   10,
+				Metadata: map[string]string{"order": order},
+			},
+			{
+				Key:      order,
+				Point:    pepsiCenter,
+				Radius:   10,
+				Metadata: map[string]string{"driver": driver},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 2 || resp.Objects[0].Object.Key != driver || resp.Objects[1].Object.Key != order {
+		t.Fatal("expected object details in request order")
+	}
+	if resp.Objects[1].Sequence != resp.Objects[0].Sequence+1 {
+		t.Fatal("expected consecutive sequences")
+	}
+	// later writes of a key in the batch build on the earlier ones
+	moved, err := geoDB.BatchSet(context.Background(), &api.BatchSetRequest{
+		Objects: []*api.Object{
+			{Key: driver, Point: pepsiCenter, Radius: 10},
+			{Key: driver, Point: saintJosephHospital, Radius: 10},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if moved.Objects[0].Version != resp.Objects[0].Version+1 || moved.Objects[1].Version != resp.Objects[0].Version+2 {
+		t.Fatalf("expected consecutive versions, got: %v %v", moved.Objects[0].Version, moved.Objects[1].Version)
+	}
+	// a single invalid object fails the entire batch
+	unassigned := fmt.Sprintf("batch_unassigned_%d", time.Now().UnixNano())
+	_, err = geoDB.BatchSet(context.Background(), &api.BatchSetRequest{
+		Objects: []*api.Object{
+			{
+				Key:    unassigned,
+				Point:  coorsField,
+				Radius: 10,
+			},
+			{
+				Key:    "_geodb_" + unassigned,
+				Point:  coorsField,
+				Radius: 10,
+			},
+		},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatal("expected invalid batch error")
+	}
+	if _, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{unassigned}}); err == nil {
+		t.Fatal("expected the failed batch not to be stored")
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: []string{driver, order}}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestSetStream(t *testing.T) {
+	prefix := fmt.Sprintf("gateway_courier_%d_", time.Now().UnixNano())
+	ss := &setStream{
+		ctx:       context.Background(),
+		requests:  make(chan *api.SetStreamRequest, 10),
+		responses: make(chan *api.SetStreamResponse, 10),
+	}
+	var keys []string
+	for i := 0; i < 3; i++ {
+		keys = append(keys, fmt.Sprintf("%s%d", prefix, i))
+		ss.requests <- &api.SetStreamRequest{
+			Id: fmt.Sprint(i),
+			Object: &api.Object{
+				Key:    keys[i],
+				Point:  coorsField,
+				Radius: 10,
+			},
+		}
+	}
+	ss.requests <- &api.SetStreamRequest{
+		Id: "reserved",
+		Object: &api.Object{
+			Key:    "_geodb_" + prefix,
+			Point:  coorsField,
+			Radius: 10,
+		},
+	}
+	// a failed precondition only fails its own write
+	ss.requests <- &api.SetStreamRequest{
+		Id: "conflict",
+		Object: &api.Object{
+			Key:    prefix + "conflict",
+			Point:  coorsField,
+			Radius: 10,
+		},
+		Precondition: &api.Precondition{ExpectedVersion: 5},
+	}
+	close(ss.requests)
+	if err := geoDB.SetStream(ss); err != nil {
+		t.Fatal(err.Error())
+	}
+	close(ss.responses)
+	var acks []*api.SetStreamResponse
+	for resp := range ss.responses {
+		acks = append(acks, resp)
+	}
+	if len(acks) != 5 {
+		t.Fatalf("expected 5 acknowledgements, got: %d", len(acks))
+	}
+	for i := 0; i < 3; i++ {
+		if acks[i].Id != fmt.Sprint(i) || acks[i].Code != 0 || acks[i].Sequence == 0 {
+			t.Fatalf("expected successful acknowledgement, got: %s", acks[i].String())
+		}
+		if i > 0 && acks[i].Sequence <= acks[i-1].Sequence {
+			t.Fatal("expected increasing sequences")
+		}
+	}
+	if acks[3].Id != "reserved" || codes.Code(acks[3].Code) != codes.InvalidArgument || acks[3].Error == "" {
+		t.Fatalf("expected reserved key to fail, got: %s", acks[3].String())
+	}
+	if acks[4].Id != "conflict" || codes.Code(acks[4].Code) != codes.FailedPrecondition {
+		t.Fatalf("expected version conflict, got: %s", acks[4].String())
+	}
+	if acks[2].Sequence != acks[0].Sequence+2 {
+		t.Fatal("expected the other objects to be set in one transaction")
+	}
+	if _, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: []string{prefix + "conflict"}}); err == nil {
+		t.Fatal("expected the conflicting object not to be stored")
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{Keys: keys})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 3 {
+		t.Fatalf("expected 3 objects, got: %d", len(resp.Objects))
+	}
+	if _, err := geoDB.Delete(context.Background(), &api.DeleteRequest{Keys: keys}); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestStreamOverflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, policy := range []stream.OverflowPolicy{stream.DropOldest, stream.DropNewest, stream.Disconnect} {
+		hub := stream.NewHub(2, policy)
+		go hub.StartObjectStream(ctx)
+		slow := hub.GetClientObjectStream(hub.AddObjectStreamClient(""))
+		fastID := hub.AddObjectStreamClient("")
+		fast := hub.GetClientObjectStream(fastID)
+		for i := 1; i <= 5; i++ {
+			hub.PublishObject(&api.ObjectDetail{Sequence: uint64(i)})
+			select {
+			case <-fast:
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected fast client to receive every message")
+			}
+		}
+		// the hub holds its lock while fanning a message out, so removing the fast client waits for the slow client to get the last message
+		hub.RemoveObjectStreamClient(fastID)
+		var received []uint64
+		for len(slow) > 0 {
+			received = append(received, (<-slow).Sequence)
+		}
+		expected := []uint64{1, 2}
+		if policy == stream.DropOldest {
+			expected = []uint64{4, 5}
+		}
+		if fmt.Sprint(received) != fmt.Sprint(expected) {
+			t.Fatalf("%s: expected %v, got %v", policy, expected, received)
+		}
+		select {
+		case _, ok := <-slow:
+			if ok || policy != stream.Disconnect {
+				t.Fatalf("%s: unexpected message or disconnect", policy)
+			}
+		default:
+			if policy == stream.Disconnect {
+				t.Fatal("expected the slow client to be disconnected")
+			}
+		}
+	}
+}
+
+func TestReliableStreamClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hub := stream.NewHub(2, stream.Disconnect)
+	go hub.StartObjectStream(ctx)
+	reliableID := hub.AddReliableObjectStreamClient("")
+	reliable := hub.GetClientObjectStream(reliableID)
+	for i := 1; i <= 5; i++ {
+		hub.PublishObject(&api.ObjectDetail{Sequence: uint64(i)})
+	}
+	for i := 1; i <= 5; i++ {
+		select {
+		case obj, ok := <-reliable:
+			if !ok || obj.Sequence != uint64(i) {
+				t.Fatalf("expected message %v, got: %v %v", i, obj.GetSequence(), ok)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected the reliable client to receive message %v", i)
+		}
+	}
+	// removing the client doesn't wait for the hub that waits for room in its buffer
+	for i := 6; i <= 10; i++ {
+		hub.PublishObject(&api.ObjectDetail{Sequence: uint64(i)})
+	}
+	removed := make(chan struct{})
+	go func() {
+		hub.RemoveObjectStreamClient(reliableID)
+		close(removed)
+	}()
+	select {
+	case <-removed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the reliable client to be removed")
+	}
+}
+
+func TestStreamClientIDs(t *testing.T) {
+	hub := stream.NewHub(2, stream.DropNewest)
+	first := hub.AddObjectStreamClient("dashboard")
+	second := hub.AddObjectStreamClient("dashboard")
+	if first != "dashboard" || second == first {
+		t.Fatalf("expected a suffixed id for the duplicate client, got: %s %s", first, second)
+	}
+	channel := hub.GetClientObjectStream(second)
+	hub.RemoveObjectStreamClient(first)
+	if hub.GetClientObjectStream(second) != channel {
+		t.Fatal("expected removing the first client to leave the second one alone")
+	}
+	select {
+	case <-channel:
+		t.Fatal("expected the second clients channel to stay open")
+	default:
+	}
+	hub.RemoveObjectStreamClient(second)
+	if geofenceID := hub.AddGeofenceStreamClient("dashboard"); geofenceID != "dashboard" || hub.AddGeofenceStreamClient("dashboard") == geofenceID {
+		t.Fatal("expected a suffixed id for the duplicate geofence client")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"testing_pepsi_center"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.GetPrefixKeys(context.Background(), &api.GetPrefixKeysRequest{
+		Prefix: "testing_",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Keys) != 1 {
+		t.Fatal("expected 1 results")
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	// registrations survive dropping every object
+	if _, err := geoDB.SetWebhook(context.Background(), &api.SetWebhookRequest{Webhook: &api.Webhook{Id: "drop_all", Url: "http://localhost/drop_all"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.SetGeofence(context.Background(), &api.SetGeofenceRequest{Geofence: &api.Geofence{Key: "drop_all", Circle: &api.Bound{Center: coorsField, Radius: 100}}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	_, err := geoDB.Delete(context.Background(), &api.DeleteRequest{
+		Keys: []string{"*"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp, err := geoDB.Get(context.Background(), &api.GetRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Objects) != 0 {
+		t.Fatal("expected 0 results")
+	}
+	hooks, err := geoDB.GetWebhooks(context.Background(), &api.GetWebhooksRequest{Ids: []string{"drop_all"}})
+	if err != nil || len(hooks.Webhooks) != 1 {
+		t.Fatalf("expected the webhook to survive: %v", err)
+	}
+	fences, err := geoDB.GetGeofences(context.Background(), &api.GetGeofencesRequest{Keys: []string{"drop_all"}})
+	if err != nil || len(fences.Geofences) != 1 {
+		t.Fatalf("expected the geofence to survive: %v", err)
+	}
+	indexes, err := geoDB.GetMetadataIndexes(context.Background(), &api.GetMetadataIndexesRequest{})
+	if err != nil || len(indexes.Keys) != 1 {
+		t.Fatalf("expected the metadata index to survive: %v", err)
+	}
+	if _, err := geoDB.DeleteWebhooks(context.Background(), &api.DeleteWebhooksRequest{Ids: []string{"drop_all"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := geoDB.DeleteGeofences(context.Background(), &api.DeleteGeofencesRequest{Keys: []string{"drop_all"}}); err != nil {
+		t.Fatal(err.Error())
 	}
 }
@@ -0,0 +1,276 @@
+package maps
+
+import (
+	"encoding/base64"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
+	"github.com/dgraph-io/badger/v2"
+	geo "github.com/paulmach/go.geo"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	directionsMeta  = 2
+	timezoneMeta    = 3
+	addressMeta     = 4
+	coordinatesMeta = 5
+	budgetMeta      = 16
+)
+
+// defaultCachePrecision is the geohash length of cache keys if none is configured, about 38m x 19m
+const defaultCachePrecision = 8
+
+// cacheSpec is the key prefix and user meta of the entries of a cache
+type cacheSpec struct {
+	prefix string
+	meta   byte
+}
+
+var caches = map[api.MapsCacheType]cacheSpec{
+	api.MapsCacheType_DirectionsCache:  {prefix: "gmaps_directions_", meta: directionsMeta},
+	api.MapsCacheType_AddressCache:     {prefix: "gmaps_address_", meta: addressMeta},
+	api.MapsCacheType_TimezoneCache:    {prefix: "gmaps_timezone_", meta: timezoneMeta},
+	api.MapsCacheType_CoordinatesCache: {prefix: "gmaps_coordinates_", meta: coordinatesMeta},
+}
+
+// cacheTypes lists the caches in the order they are reported
+var cacheTypes = []api.MapsCacheType{
+	api.MapsCacheType_DirectionsCache,
+	api.MapsCacheType_AddressCache,
+	api.MapsCacheType_TimezoneCache,
+	api.MapsCacheType_CoordinatesCache,
+}
+
+// CacheConfig configures how a Client caches the responses of its provider
+type CacheConfig struct {
+	// Precision is the geohash length points are bucketed by in directions and address cache keys(default: 8)
+	Precision int
+	// TimezonePrecision is the geohash length points are bucketed by in timezone cache keys, it is raised to Precision if it is coarser
+	TimezonePrecision int
+	// DirectionsExpiration is how long directions are cached, the other responses are cached until they are evicted or purged
+	DirectionsExpiration time.Duration
+	// MaxEntries bounds the number of entries of every cache, 0 is unbounded
+	MaxEntries int64
+}
+
+// cacheState tracks the bound, size and hit rate of a cache
+type cacheState struct {
+	maxEntries int64
+	// entries is an upper bound of the number of entries(overwrites are counted as well), -1 until the cache was counted
+	entries int64
+	hits    uint64
+	misses  uint64
+}
+
+// getCached returns the cached value at key or nil on a miss
+func (c *Client) getCached(cache api.MapsCacheType, key string) ([]byte, error) {
+	txn := c.db.NewTransaction(false)
+	defer txn.Discard()
+	item, err := txn.Get([]byte(key))
+	if err == badger.ErrKeyNotFound {
+		c.cacheMu.Lock()
+		c.caches[cache].misses++
+		c.cacheMu.Unlock()
+		metrics.IncMapsCacheMiss(cache.String())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	c.cacheMu.Lock()
+	c.caches[cache].hits++
+	c.cacheMu.Unlock()
+	metrics.IncMapsCacheHit(cache.String())
+	return res, nil
+}
+
+// setCached caches the value at key(ttl 0 never expires) and evicts the oldest entries of the cache once it exceeds its bound
+func (c *Client) setCached(cache api.MapsCacheType, key string, value []byte, ttl time.Duration) error {
+	entry := &badger.Entry{
+		Key:      []byte(key),
+		Value:    value,
+		UserMeta: caches[cache].meta,
+	}
+	if ttl > 0 {
+		entry.ExpiresAt = uint64(time.Now().Add(ttl).Unix())
+	}
+	txn := c.db.NewTransaction(true)
+	defer txn.Discard()
+	if err := txn.SetEntry(entry); err != nil {
+		return err
+	}
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	state := c.caches[cache]
+	if state.entries < 0 {
+		state.entries, _ = c.countCache(cache)
+	} else {
+		state.entries++
+	}
+	if state.maxEntries > 0 && state.entries > state.maxEntries {
+		// evicting down to 90% of the bound spares a scan of the cache on every following write
+		evicted, err := c.evict(cache, state.maxEntries-state.maxEntries/10)
+		if err != nil {
+			return err
+		}
+		metrics.AddMapsCacheEvictions(cache.String(), int(evicted))
+	}
+	return nil
+}
+
+// countCache returns the number of entries of the cache and their estimated size
+func (c *Client) countCache(cache api.MapsCacheType) (int64, int64) {
+	txn := c.db.NewTransaction(false)
+	defer txn.Discard()
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	defer iter.Close()
+	prefix := []byte(caches[cache].prefix)
+	var entries, size int64
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		if iter.Item().UserMeta() != caches[cache].meta {
+			continue
+		}
+		entries++
+		size += iter.Item().EstimatedSize()
+	}
+	return entries, size
+}
+
+// evict deletes the oldest entries of the cache until at most max entries are left. The caller must hold cacheMu.
+func (c *Client) evict(cache api.MapsCacheType, max int64) (int64, error) {
+	type cached struct {
+		key     []byte
+		version uint64
+	}
+	var entries []cached
+	txn := c.db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	iter := txn.NewIterator(opts)
+	prefix := []byte(caches[cache].prefix)
+	for iter.Seek(prefix); iter.ValidForPrefix(prefix); iter.Next() {
+		item := iter.Item()
+		if item.UserMeta() != caches[cache].meta {
+			continue
+		}
+		entries = append(entries, cached{key: item.KeyCopy(nil), version: item.Version()})
+	}
+	iter.Close()
+	txn.Discard()
+	state := c.caches[cache]
+	if int64(len(entries)) <= max {
+		state.entries = int64(len(entries))
+		return 0, nil
+	}
+	// badger versions are commit timestamps, the smallest ones were written first
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].version < entries[j].version
+	})
+	evicted := entries[:int64(len(entries))-max]
+	wb := c.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, entry := range evicted {
+		if err := wb.Delete(entry.key); err != nil {
+			return 0, err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return 0, err
+	}
+	state.entries = max
+	return int64(len(evicted)), nil
+}
+
+// CacheStats returns the number of entries, size, bound and hit rate of every cache
+func (c *Client) CacheStats() ([]*api.MapsCacheStats, error) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	var stats []*api.MapsCacheStats
+	for _, cache := range cacheTypes {
+		entries, size := c.countCache(cache)
+		state := c.caches[cache]
+		state.entries = entries
+		stats = append(stats, &api.MapsCacheStats{
+			Type:       cache,
+			Entries:    entries,
+			SizeBytes:  size,
+			MaxEntries: state.maxEntries,
+			Hits:       state.hits,
+			Misses:     state.misses,
+		})
+	}
+	return stats, nil
+}
+
+// PurgeCache deletes every entry of the caches(every cache if none are passed) and returns the number of deleted entries
+func (c *Client) PurgeCache(types ...api.MapsCacheType) (int64, error) {
+	if len(types) == 0 {
+		types = cacheTypes
+	}
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	var purged int64
+	for _, cache := range types {
+		if _, ok := caches[cache]; !ok {
+			return purged, fmt.Errorf("unknown maps cache: %v", cache)
+		}
+		evicted, err := c.evict(cache, 0)
+		if err != nil {
+			return purged, err
+		}
+		purged += evicted
+	}
+	return purged, nil
+}
+
+// BoundCache bounds the number of entries of the cache(0 removes the bound) and returns the number of entries evicted to meet the bound
+func (c *Client) BoundCache(cache api.MapsCacheType, maxEntries int64) (int64, error) {
+	if _, ok := caches[cache]; !ok {
+		return 0, fmt.Errorf("unknown maps cache: %v", cache)
+	}
+	if maxEntries < 0 {
+		return 0, fmt.Errorf("max entries must not be negative: %v", maxEntries)
+	}
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	c.caches[cache].maxEntries = maxEntries
+	if maxEntries == 0 {
+		return 0, nil
+	}
+	evicted, err := c.evict(cache, maxEntries)
+	if err != nil {
+		return 0, err
+	}
+	metrics.AddMapsCacheEvictions(cache.String(), int(evicted))
+	return evicted, nil
+}
+
+func (c *Client) directionsCacheKey(origin, destination *geo.Point, mode api.TravelMode) string {
+	originHash := origin.GeoHash(c.precision)
+	destHash := destination.GeoHash(c.precision)
+	return fmt.Sprintf("%s%s_%s_%s", caches[api.MapsCacheType_DirectionsCache].prefix, mode, originHash, destHash)
+}
+
+func (c *Client) addressCacheKey(point *geo.Point) string {
+	return caches[api.MapsCacheType_AddressCache].prefix + point.GeoHash(c.precision)
+}
+
+func (c *Client) timezoneCacheKey(point *geo.Point) string {
+	return caches[api.MapsCacheType_TimezoneCache].prefix + point.GeoHash(c.timezonePrecision)
+}
+
+func (c *Client) coordinatesCacheKey(address string) string {
+	return caches[api.MapsCacheType_CoordinatesCache].prefix + base64.StdEncoding.EncodeToString([]byte(strings.ToLower(strings.TrimSpace(address))))
+}
@@ -0,0 +1,222 @@
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/gogo/protobuf/proto"
+	"googlemaps.github.io/maps"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+// FakeRequest is a kind of request answered by the Fake provider
+type FakeRequest string
+
+const (
+	FakeDirections     FakeRequest = "directions"
+	FakeReverseGeocode FakeRequest = "reverse_geocode"
+	FakeGeocode        FakeRequest = "geocode"
+	FakeTimezone       FakeRequest = "timezone"
+)
+
+// FakeRoute is a scripted route of the Fake provider. The distance is split evenly between the instructions.
+type FakeRoute struct {
+	Meters       int      `json:"meters"`
+	Seconds      int      `json:"seconds"`
+	Instructions []string `json:"instructions"`
+}
+
+// FakeScript holds the scripted responses of the Fake provider by kind of request
+type FakeScript struct {
+	Directions []FakeRoute    `json:"directions"`
+	Addresses  []*api.Address `json:"addresses"`
+	Points     []*api.Point   `json:"points"`
+	Timezones  []string       `json:"timezones"`
+}
+
+// fakeResponses is the script of one kind of request, a response is either a value or an error
+type fakeResponses struct {
+	values []interface{}
+	errs   []error
+	next   int
+	calls  int
+}
+
+func (f *fakeResponses) add(value interface{}, err error) {
+	f.values = append(f.values, value)
+	f.errs = append(f.errs, err)
+}
+
+func (f *fakeResponses) pop() (interface{}, error) {
+	f.calls++
+	if len(f.values) == 0 {
+		return nil, ErrNoProvider
+	}
+	i := len(f.values) - 1
+	if f.next < len(f.values) {
+		i = f.next
+		f.next++
+	}
+	return f.values[i], f.errs[i]
+}
+
+// Fake is an in-process Provider answering with scripted responses so features depending on maps can be tested deterministically
+// without network access. Every request consumes the next scripted response of its kind and the last one is repeated once the script
+// is exhausted. Kinds of requests without a script fail with ErrNoProvider.
+type Fake struct {
+	mu        sync.Mutex
+	responses map[FakeRequest]*fakeResponses
+}
+
+// NewFake creates a Fake provider without scripted responses
+func NewFake() *Fake {
+	return &Fake{
+		responses: map[FakeRequest]*fakeResponses{
+			FakeDirections:     {},
+			FakeReverseGeocode: {},
+			FakeGeocode:        {},
+			FakeTimezone:       {},
+		},
+	}
+}
+
+// LoadFake creates a Fake provider answering with the script of the json file at path
+func LoadFake(path string) (*Fake, error) {
+	bits, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var script FakeScript
+	if err := json.Unmarshal(bits, &script); err != nil {
+		return nil, err
+	}
+	f := NewFake()
+	f.ScriptDirections(script.Directions...)
+	f.ScriptReverseGeocode(script.Addresses...)
+	f.ScriptGeocode(script.Points...)
+	f.ScriptTimezone(script.Timezones...)
+	return f, nil
+}
+
+// ScriptDirections appends routes to the directions script
+func (f *Fake) ScriptDirections(routes ...FakeRoute) *Fake {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, route := range routes {
+		f.responses[FakeDirections].add(route, nil)
+	}
+	return f
+}
+
+// ScriptReverseGeocode appends addresses to the reverse geocoding script
+func (f *Fake) ScriptReverseGeocode(addresses ...*api.Address) *Fake {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, address := range addresses {
+		f.responses[FakeReverseGeocode].add(address, nil)
+	}
+	return f
+}
+
+// ScriptGeocode appends points to the geocoding script
+func (f *Fake) ScriptGeocode(points ...*api.Point) *Fake {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, point := range points {
+		f.responses[FakeGeocode].add(point, nil)
+	}
+	return f
+}
+
+// ScriptTimezone appends timezone ids to the timezone script
+func (f *Fake) ScriptTimezone(zones ...string) *Fake {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, zone := range zones {
+		f.responses[FakeTimezone].add(zone, nil)
+	}
+	return f
+}
+
+// ScriptError appends a failing response to the script of the kind of request
+func (f *Fake) ScriptError(request FakeRequest, err error) *Fake {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[request].add(nil, err)
+	return f
+}
+
+// Calls returns the number of requests of the kind the provider received
+func (f *Fake) Calls(request FakeRequest) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.responses[request].calls
+}
+
+func (f *Fake) pop(request FakeRequest) (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.responses[request].pop()
+}
+
+func (f *Fake) Directions(ctx context.Context, origin, dest *api.Point, mode api.TravelMode) ([]maps.Route, error) {
+	value, err := f.pop(FakeDirections)
+	if err != nil {
+		return nil, err
+	}
+	route := value.(FakeRoute)
+	leg := &maps.Leg{
+		Distance: maps.Distance{
+			HumanReadable: humanReadable(float64(route.Meters)),
+			Meters:        route.Meters,
+		},
+		Duration:      time.Duration(route.Seconds) * time.Second,
+		StartAddress:  pointString(origin),
+		EndAddress:    pointString(dest),
+		StartLocation: maps.LatLng{Lat: origin.Lat, Lng: origin.Lon},
+		EndLocation:   maps.LatLng{Lat: dest.Lat, Lng: dest.Lon},
+	}
+	leg.DurationInTraffic = leg.Duration
+	for _, instruction := range route.Instructions {
+		meters := route.Meters / len(route.Instructions)
+		leg.Steps = append(leg.Steps, &maps.Step{
+			HTMLInstructions: instruction,
+			Distance: maps.Distance{
+				HumanReadable: humanReadable(float64(meters)),
+				Meters:        meters,
+			},
+			Duration: leg.Duration / time.Duration(len(route.Instructions)),
+		})
+	}
+	return []maps.Route{{
+		Summary: fmt.Sprintf("%s route", mode.String()),
+		Legs:    []*maps.Leg{leg},
+	}}, nil
+}
+
+func (f *Fake) ReverseGeocode(ctx context.Context, point *api.Point) (*api.Address, error) {
+	value, err := f.pop(FakeReverseGeocode)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(value.(*api.Address)).(*api.Address), nil
+}
+
+func (f *Fake) Geocode(ctx context.Context, address string) (*api.Point, error) {
+	value, err := f.pop(FakeGeocode)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(value.(*api.Point)).(*api.Point), nil
+}
+
+func (f *Fake) Timezone(ctx context.Context, point *api.Point) (string, error) {
+	value, err := f.pop(FakeTimezone)
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
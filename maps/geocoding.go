@@ -0,0 +1,151 @@
+package maps
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/gogo/protobuf/proto"
+	geo "github.com/paulmach/go.geo"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ErrNoResults is returned by a GeocodingProvider if an address can't be found
+var ErrNoResults = errors.New("maps: no results found")
+
+// GeocodingProvider looks up the address of a point(reverse geocoding) and the point of an address(geocoding)
+type GeocodingProvider interface {
+	ReverseGeocode(ctx context.Context, point *api.Point) (*api.Address, error)
+	Geocode(ctx context.Context, address string) (*api.Point, error)
+}
+
+// Gazetteer is a GeocodingProvider returning the address of the place closest to a point from a dataset held in memory(ErrNoResults if
+// the closest place is further away than the max distance), addresses are geocoded to the place with the same postal code, place name or
+// "place name, state". Places are read
+// from the GeoNames postal code format: tab(or comma) separated rows of country code, postal code, place name, admin name1(state),
+// admin code1, admin name2(county), admin code2, admin name3, admin code3, latitude, longitude and accuracy.
+type Gazetteer struct {
+	places  *pointIndex
+	address []*api.Address
+	// place ids by lower case postal code, place name and "place name, admin name1"
+	names map[string]int
+	// maxDistance is the max distance in meters between a point and the place it is reverse geocoded to, 0 is unbounded
+	maxDistance float64
+}
+
+// LoadGazetteer loads the places of the GeoNames postal code file at path. Points further than maxDistance meters(0 is unbounded) from
+// every place have no address.
+func LoadGazetteer(path string, maxDistance float64) (*Gazetteer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return NewGazetteer(f, maxDistance)
+}
+
+// NewGazetteer reads places in the GeoNames postal code format. Points further than maxDistance meters(0 is unbounded) from every place
+// have no address.
+func NewGazetteer(r io.Reader, maxDistance float64) (*Gazetteer, error) {
+	g := &Gazetteer{
+		places:      newPointIndex(),
+		names:       map[string]int{},
+		maxDistance: maxDistance,
+	}
+	// the delimiter is detected from the first row
+	buffered := bufio.NewReader(r)
+	first, err := buffered.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	reader := csv.NewReader(io.MultiReader(strings.NewReader(first), buffered))
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	if strings.Contains(first, "\t") {
+		reader.Comma = '\t'
+	}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 11 {
+			return nil, fmt.Errorf("line %d: expected at least 11 columns, got %d", line, len(record))
+		}
+		lat, err := strconv.ParseFloat(strings.TrimSpace(record[9]), 64)
+		if err != nil {
+			if line == 1 {
+				// header
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid latitude: %s", line, record[9])
+		}
+		lon, err := strconv.ParseFloat(strings.TrimSpace(record[10]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude: %s", line, record[10])
+		}
+		address := &api.Address{
+			Country: strings.TrimSpace(record[0]),
+			Zip:     strings.TrimSpace(record[1]),
+			City:    strings.TrimSpace(record[2]),
+			State:   strings.TrimSpace(record[3]),
+			County:  strings.TrimSpace(record[5]),
+		}
+		address.Address = formatAddress(address)
+		id := g.places.add(geo.NewPointFromLatLng(lat, lon))
+		g.address = append(g.address, address)
+		for _, name := range []string{address.Zip, address.City, address.City + ", " + address.State} {
+			name = strings.ToLower(name)
+			// the first place of a name wins
+			if _, ok := g.names[name]; !ok && name != "" {
+				g.names[name] = id
+			}
+		}
+	}
+	if g.places.len() == 0 {
+		return nil, fmt.Errorf("gazetteer has no places")
+	}
+	return g, nil
+}
+
+// formatAddress formats an address like "city, state zip, country"
+func formatAddress(address *api.Address) string {
+	var parts []string
+	if address.City != "" {
+		parts = append(parts, address.City)
+	}
+	if state := strings.TrimSpace(address.State + " " + address.Zip); state != "" {
+		parts = append(parts, state)
+	}
+	if address.Country != "" {
+		parts = append(parts, address.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ReverseGeocode returns the address of the place closest to the point, or ErrNoResults if it is further away than the max distance
+func (g *Gazetteer) ReverseGeocode(ctx context.Context, point *api.Point) (*api.Address, error) {
+	id, _ := g.places.nearest(geo.NewPointFromLatLng(point.Lat, point.Lon), g.maxDistance, nil)
+	if id < 0 {
+		return nil, ErrNoResults
+	}
+	return proto.Clone(g.address[id]).(*api.Address), nil
+}
+
+// Geocode returns the point of the place whose postal code, name or "name, state" is the address
+func (g *Gazetteer) Geocode(ctx context.Context, address string) (*api.Point, error) {
+	id, ok := g.names[strings.ToLower(strings.TrimSpace(address))]
+	if !ok {
+		return nil, ErrNoResults
+	}
+	point := g.places.points[id]
+	return &api.Point{Lat: point.Lat(), Lon: point.Lng()}, nil
+}
@@ -0,0 +1,108 @@
+package maps
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/helpers"
+	"googlemaps.github.io/maps"
+	"time"
+)
+
+// Google is the Provider backed by the google maps apis
+type Google struct {
+	client *maps.Client
+}
+
+// NewGoogle creates a google maps provider
+func NewGoogle(apiKey string) (*Google, error) {
+	client, err := maps.NewClient(maps.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+	return &Google{
+		client: client,
+	}, nil
+}
+
+func (g *Google) Directions(ctx context.Context, origin, dest *api.Point, mode api.TravelMode) ([]maps.Route, error) {
+	resp, _, err := g.client.Directions(ctx, &maps.DirectionsRequest{
+		Origin:        pointString(origin),
+		Destination:   pointString(dest),
+		Mode:          helpers.ToTravelMode(mode),
+		DepartureTime: "now",
+		TrafficModel:  maps.TrafficModelBestGuess,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, ErrNoRoute
+	}
+	return resp, nil
+}
+
+func (g *Google) ReverseGeocode(ctx context.Context, point *api.Point) (*api.Address, error) {
+	resp, err := g.client.ReverseGeocode(ctx, &maps.GeocodingRequest{
+		LatLng: &maps.LatLng{
+			Lat: point.Lat,
+			Lng: point.Lon,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var address = &api.Address{}
+	for _, res := range resp {
+		address.Address = res.FormattedAddress
+		for _, addressComponent := range res.AddressComponents {
+			for _, t := range addressComponent.Types {
+				switch t {
+				case "administrative_area_level_1":
+					address.State = addressComponent.LongName
+				case "administrative_area_level_2":
+					address.County = addressComponent.LongName
+				case "country":
+					address.Country = addressComponent.LongName
+				case "postal_code":
+					address.Zip = addressComponent.LongName
+				case "locality", "sublocality":
+					address.City = addressComponent.LongName
+				default:
+					continue
+				}
+			}
+		}
+		break
+	}
+	return address, nil
+}
+
+func (g *Google) Timezone(ctx context.Context, point *api.Point) (string, error) {
+	resp, err := g.client.Timezone(ctx, &maps.TimezoneRequest{
+		Location: &maps.LatLng{
+			Lat: point.Lat,
+			Lng: point.Lon,
+		},
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.TimeZoneID, nil
+}
+
+func (g *Google) Geocode(ctx context.Context, address string) (*api.Point, error) {
+	resp, err := g.client.Geocode(ctx, &maps.GeocodingRequest{
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, ErrNoResults
+	}
+	return &api.Point{
+		Lon: resp[0].Geometry.Location.Lng,
+		Lat: resp[0].Geometry.Location.Lat,
+	}, nil
+}
@@ -0,0 +1,347 @@
+package maps
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geo "github.com/paulmach/go.geo"
+	geojson "github.com/paulmach/go.geojson"
+	"googlemaps.github.io/maps"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// speedProfile is the speed of a travel mode in meters per second and the road classes(the highway property of a road) it may not use
+type speedProfile struct {
+	speed     float64
+	forbidden map[string]bool
+	// oneway roads are only restricted for vehicles
+	oneway bool
+	// the maxspeed property of a road caps the speed
+	maxSpeed bool
+}
+
+var speedProfiles = map[api.TravelMode]speedProfile{
+	api.TravelMode_Driving: {
+		speed:     50 / 3.6,
+		forbidden: roadClasses("footway", "path", "pedestrian", "steps", "cycleway", "bridleway", "track"),
+		oneway:    true,
+		maxSpeed:  true,
+	},
+	api.TravelMode_Walking: {
+		speed:     5 / 3.6,
+		forbidden: roadClasses("motorway", "motorway_link", "trunk", "trunk_link"),
+	},
+	api.TravelMode_Bicycling: {
+		speed:     15 / 3.6,
+		forbidden: roadClasses("motorway", "motorway_link", "trunk", "trunk_link", "steps"),
+		oneway:    true,
+	},
+	api.TravelMode_Transit: {
+		speed:     25 / 3.6,
+		forbidden: roadClasses("footway", "path", "pedestrian", "steps", "cycleway", "bridleway", "track"),
+		oneway:    true,
+		maxSpeed:  true,
+	},
+}
+
+func roadClasses(classes ...string) map[string]bool {
+	m := map[string]bool{}
+	for _, class := range classes {
+		m[class] = true
+	}
+	return m
+}
+
+type graphEdge struct {
+	to     int
+	meters float64
+	road   *road
+	// the edge runs against the direction of a oneway road
+	against bool
+}
+
+type road struct {
+	name    string
+	highway string
+	// max speed in meters per second, 0 if unknown
+	maxSpeed float64
+	oneway   bool
+}
+
+// GraphRouter is a RoutingProvider computing the fastest path over a road graph held in memory. Roads are the LineString and
+// MultiLineString features of a GeoJSON feature collection, lines sharing a coordinate are connected there. The name, highway, maxspeed(km/h)
+// and oneway(yes, true, 1 or -1 if the road runs against the order of its coordinates) properties of a road are used if present.
+type GraphRouter struct {
+	nodes *pointIndex
+	edges [][]graphEdge
+}
+
+// LoadGraphRouter loads the road graph of the GeoJSON file at path
+func LoadGraphRouter(path string) (*GraphRouter, error) {
+	bits, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewGraphRouter(bits)
+}
+
+// NewGraphRouter builds the road graph of a GeoJSON feature collection
+func NewGraphRouter(data []byte) (*GraphRouter, error) {
+	collection, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		return nil, err
+	}
+	g := &GraphRouter{
+		nodes: newPointIndex(),
+	}
+	ids := map[[2]float64]int{}
+	node := func(coordinate []float64) int {
+		pos := [2]float64{coordinate[0], coordinate[1]}
+		if id, ok := ids[pos]; ok {
+			return id
+		}
+		id := g.nodes.add(geo.NewPointFromLatLng(pos[1], pos[0]))
+		ids[pos] = id
+		g.edges = append(g.edges, nil)
+		return id
+	}
+	for i, feature := range collection.Features {
+		if feature.Geometry == nil {
+			continue
+		}
+		var lines [][][]float64
+		switch feature.Geometry.Type {
+		case geojson.GeometryLineString:
+			lines = [][][]float64{feature.Geometry.LineString}
+		case geojson.GeometryMultiLineString:
+			lines = feature.Geometry.MultiLineString
+		default:
+			continue
+		}
+		r := &road{}
+		r.name, _ = feature.PropertyString("name")
+		r.highway, _ = feature.PropertyString("highway")
+		if speed, ok := feature.Properties["maxspeed"]; ok {
+			kmh, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(fmt.Sprint(speed), "km/h")), 64)
+			if err != nil {
+				return nil, fmt.Errorf("feature %d: invalid maxspeed: %v", i, speed)
+			}
+			r.maxSpeed = kmh / 3.6
+		}
+		// oneway may be a string, bool or number
+		oneway := fmt.Sprint(feature.Properties["oneway"])
+		forward := oneway == "yes" || oneway == "true" || oneway == "1"
+		reverse := oneway == "-1" || oneway == "reverse"
+		r.oneway = forward || reverse
+		for _, line := range lines {
+			for j := 1; j < len(line); j++ {
+				if len(line[j-1]) < 2 || len(line[j]) < 2 {
+					return nil, fmt.Errorf("feature %d: invalid coordinate", i)
+				}
+				from, to := node(line[j-1]), node(line[j])
+				if from == to {
+					continue
+				}
+				meters := g.nodes.points[from].GeoDistanceFrom(g.nodes.points[to], true)
+				g.edges[from] = append(g.edges[from], graphEdge{to: to, meters: meters, road: r, against: reverse})
+				g.edges[to] = append(g.edges[to], graphEdge{to: from, meters: meters, road: r, against: forward})
+			}
+		}
+	}
+	if g.nodes.len() == 0 {
+		return nil, fmt.Errorf("road graph has no roads")
+	}
+	return g, nil
+}
+
+func (p speedProfile) seconds(e graphEdge) (float64, bool) {
+	if p.forbidden[e.road.highway] || (p.oneway && e.against) {
+		return 0, false
+	}
+	speed := p.speed
+	if p.maxSpeed && e.road.maxSpeed > 0 && e.road.maxSpeed < speed {
+		speed = e.road.maxSpeed
+	}
+	return e.meters / speed, true
+}
+
+// arrives returns true if the profile may travel the edge in reverse, i.e. arrive at the node the edge leaves from
+func (p speedProfile) arrives(e graphEdge) bool {
+	return !p.forbidden[e.road.highway] && !(p.oneway && e.road.oneway && !e.against)
+}
+
+// departable returns true if the profile may leave the node over one of its edges
+func (g *GraphRouter) departable(profile speedProfile, node int) bool {
+	for _, e := range g.edges[node] {
+		if _, ok := profile.seconds(e); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// arrivable returns true if the profile may arrive at the node over one of its edges
+func (g *GraphRouter) arrivable(profile speedProfile, node int) bool {
+	for _, e := range g.edges[node] {
+		if profile.arrives(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Directions returns the fastest route between the nodes closest to the origin and the destination that the travel mode may leave and arrive
+// at(e.g. driving never starts on a footway). The straight distance between the points and their nodes is added to the route.
+func (g *GraphRouter) Directions(ctx context.Context, origin, dest *api.Point, mode api.TravelMode) ([]maps.Route, error) {
+	profile, ok := speedProfiles[mode]
+	if !ok {
+		profile = speedProfiles[api.TravelMode_Driving]
+	}
+	start, startDist := g.nodes.nearest(geo.NewPointFromLatLng(origin.Lat, origin.Lon), 0, func(node int) bool {
+		return g.departable(profile, node)
+	})
+	end, endDist := g.nodes.nearest(geo.NewPointFromLatLng(dest.Lat, dest.Lon), 0, func(node int) bool {
+		return g.arrivable(profile, node)
+	})
+	if start < 0 || end < 0 {
+		return nil, ErrNoRoute
+	}
+	path, err := g.shortestPath(ctx, profile, start, end)
+	if err != nil {
+		return nil, err
+	}
+	meters := startDist + endDist
+	seconds := meters / profile.speed
+	leg := &maps.Leg{
+		StartAddress:  pointString(origin),
+		EndAddress:    pointString(dest),
+		StartLocation: maps.LatLng{Lat: origin.Lat, Lng: origin.Lon},
+		EndLocation:   maps.LatLng{Lat: dest.Lat, Lng: dest.Lon},
+	}
+	var names []string
+	from := start
+	for i, e := range path {
+		s, _ := profile.seconds(e)
+		meters += e.meters
+		seconds += s
+		if i == 0 || path[i-1].road.name != e.road.name {
+			leg.Steps = append(leg.Steps, &maps.Step{
+				HTMLInstructions: instruction(i == 0, e.road.name),
+				StartLocation:    g.latLng(from),
+			})
+			if e.road.name != "" && len(names) < 2 {
+				names = append(names, e.road.name)
+			}
+		}
+		step := leg.Steps[len(leg.Steps)-1]
+		step.Meters += int(math.Round(e.meters))
+		step.HumanReadable = humanReadable(float64(step.Meters))
+		step.Duration += time.Duration(s * float64(time.Second))
+		step.EndLocation = g.latLng(e.to)
+		from = e.to
+	}
+	leg.Distance = maps.Distance{
+		HumanReadable: humanReadable(meters),
+		Meters:        int(math.Round(meters)),
+	}
+	leg.Duration = time.Duration(seconds * float64(time.Second))
+	leg.DurationInTraffic = leg.Duration
+	return []maps.Route{{
+		Summary: strings.Join(names, " and "),
+		Legs:    []*maps.Leg{leg},
+	}}, nil
+}
+
+func (g *GraphRouter) latLng(node int) maps.LatLng {
+	return maps.LatLng{Lat: g.nodes.points[node].Lat(), Lng: g.nodes.points[node].Lng()}
+}
+
+// shortestPath returns the edges of the fastest path from start to end(dijkstra)
+func (g *GraphRouter) shortestPath(ctx context.Context, profile speedProfile, start, end int) ([]graphEdge, error) {
+	seconds := map[int]float64{start: 0}
+	previous := map[int]graphEdge{}
+	from := map[int]int{}
+	done := map[int]bool{}
+	queue := &nodeQueue{{node: start}}
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		current := heap.Pop(queue).(queuedNode)
+		if done[current.node] {
+			continue
+		}
+		done[current.node] = true
+		if current.node == end {
+			break
+		}
+		for _, e := range g.edges[current.node] {
+			s, ok := profile.seconds(e)
+			if !ok || done[e.to] {
+				continue
+			}
+			if known, ok := seconds[e.to]; ok && known <= current.seconds+s {
+				continue
+			}
+			seconds[e.to] = current.seconds + s
+			previous[e.to] = e
+			from[e.to] = current.node
+			heap.Push(queue, queuedNode{node: e.to, seconds: current.seconds + s})
+		}
+	}
+	if !done[end] {
+		return nil, ErrNoRoute
+	}
+	var path []graphEdge
+	for node := end; node != start; node = from[node] {
+		path = append(path, previous[node])
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, nil
+}
+
+type queuedNode struct {
+	node    int
+	seconds float64
+}
+
+// nodeQueue is a min heap of nodes ordered by travel time
+type nodeQueue []queuedNode
+
+func (q nodeQueue) Len() int            { return len(q) }
+func (q nodeQueue) Less(i, j int) bool  { return q[i].seconds < q[j].seconds }
+func (q nodeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(x interface{}) { *q = append(*q, x.(queuedNode)) }
+func (q *nodeQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
+
+func instruction(first bool, name string) string {
+	switch {
+	case first && name != "":
+		return fmt.Sprintf("Head along <b>%s</b>", name)
+	case first:
+		return "Head along the road"
+	case name != "":
+		return fmt.Sprintf("Continue onto <b>%s</b>", name)
+	default:
+		return "Continue along the road"
+	}
+}
+
+func humanReadable(meters float64) string {
+	if meters < 1000 {
+		return fmt.Sprintf("%d m", int(math.Round(meters)))
+	}
+	return fmt.Sprintf("%.1f km", meters/1000)
+}
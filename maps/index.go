@@ -0,0 +1,109 @@
+package maps
+
+import (
+	geo "github.com/paulmach/go.geo"
+	"math"
+)
+
+// gridSize is the size of the cells(in degrees) of a pointIndex
+const gridSize = 0.01
+
+type gridCell struct {
+	lat, lon int
+}
+
+func cellOf(lat, lon float64) gridCell {
+	return gridCell{lat: int(math.Floor(lat / gridSize)), lon: int(math.Floor(lon / gridSize))}
+}
+
+// pointIndex is an in-memory grid of points used to find the point nearest to a location. Points are identified by the order they were
+// added in.
+type pointIndex struct {
+	points []*geo.Point
+	grid   map[gridCell][]int
+	// min and max are the corners of the cells holding points
+	min, max gridCell
+}
+
+func newPointIndex() *pointIndex {
+	return &pointIndex{
+		grid: map[gridCell][]int{},
+	}
+}
+
+// add adds the point to the index and returns its id
+func (p *pointIndex) add(point *geo.Point) int {
+	id := len(p.points)
+	p.points = append(p.points, point)
+	cell := cellOf(point.Lat(), point.Lng())
+	p.grid[cell] = append(p.grid[cell], id)
+	if id == 0 {
+		p.min, p.max = cell, cell
+	}
+	p.min = gridCell{lat: minInt(p.min.lat, cell.lat), lon: minInt(p.min.lon, cell.lon)}
+	p.max = gridCell{lat: maxInt(p.max.lat, cell.lat), lon: maxInt(p.max.lon, cell.lon)}
+	return id
+}
+
+func (p *pointIndex) len() int {
+	return len(p.points)
+}
+
+// nearest returns the id of the point closest to the location that is accepted by accept(optional) and within maxDistance meters(0 is
+// unbounded), and its distance in meters. The id is -1 if there is no such point. Rings of grid cells around the location are searched
+// until every point outside of them is further away than the closest point found or maxDistance, or every cell holding points was searched.
+func (p *pointIndex) nearest(point *geo.Point, maxDistance float64, accept func(id int) bool) (int, float64) {
+	if len(p.points) == 0 {
+		return -1, -1
+	}
+	center := cellOf(point.Lat(), point.Lng())
+	best, bestDist := -1, math.MaxFloat64
+	// no point lies outside of the rings covering the cells between min and max
+	rings := maxInt(maxInt(center.lat-p.min.lat, p.max.lat-center.lat), maxInt(center.lon-p.min.lon, p.max.lon-center.lon))
+	for ring := 0; ring <= rings; ring++ {
+		for lat := center.lat - ring; lat <= center.lat+ring; lat++ {
+			for lon := center.lon - ring; lon <= center.lon+ring; lon++ {
+				if lat != center.lat-ring && lat != center.lat+ring && lon != center.lon-ring && lon != center.lon+ring {
+					continue
+				}
+				for _, id := range p.grid[gridCell{lat: lat, lon: lon}] {
+					if accept != nil && !accept(id) {
+						continue
+					}
+					if d := point.GeoDistanceFrom(p.points[id], true); d < bestDist {
+						best, bestDist = id, d
+					}
+				}
+			}
+		}
+		unsearched := ringDistance(point, ring)
+		if unsearched >= bestDist || (maxDistance > 0 && unsearched > maxDistance) {
+			break
+		}
+	}
+	if best < 0 || (maxDistance > 0 && bestDist > maxDistance) {
+		return -1, -1
+	}
+	return best, bestDist
+}
+
+// ringDistance returns the min distance in meters between the point and the points outside of the rings 0..ring around its cell. Those
+// points are at least ring cells away in latitude or longitude, the distance of a longitude offset shrinks with the cosine of the latitude.
+func ringDistance(point *geo.Point, ring int) float64 {
+	offset := math.Min(float64(ring)*gridSize*math.Pi/180, math.Pi/2)
+	return geo.EarthRadius * math.Asin(math.Cos(point.Lat()*math.Pi/180)*math.Sin(offset))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,250 @@
+package maps
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
+	"github.com/dgraph-io/badger/v2"
+	geo "github.com/paulmach/go.geo"
+	log "github.com/sirupsen/logrus"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrRateLimited is returned if a request exceeds the QPS limit of the provider
+	ErrRateLimited = errors.New("maps: rate limited")
+	// ErrBudgetExceeded is returned once the daily request budget of the provider is used up
+	ErrBudgetExceeded = errors.New("maps: daily budget exceeded")
+	// ErrCircuitOpen is returned while the circuit breaker is open after the provider failed repeatedly
+	ErrCircuitOpen = errors.New("maps: circuit breaker open")
+)
+
+// LimitConfig configures how many requests a Client sends to its provider
+type LimitConfig struct {
+	// QPS is the max number of provider requests per second(with bursts of up to QPS requests, at least one), 0 is unlimited
+	QPS float64
+	// DailyBudget is the max number of provider requests per UTC day, 0 is unlimited
+	DailyBudget int64
+	// BreakerFailures is the number of consecutive provider failures that open the circuit breaker, 0 disables it
+	BreakerFailures int
+	// BreakerCooldown is how long the circuit breaker stays open before a single request may probe the provider again
+	BreakerCooldown time.Duration
+}
+
+// budgetPrefix namespaces the number of provider requests sent per day. It lives in the keyspace the db package reserves(_geodb_) so
+// objects can't collide with it.
+const budgetPrefix = "_geodb_gmaps_budget_"
+
+// limiter enforces the LimitConfig of a Client
+type limiter struct {
+	mu     sync.Mutex
+	config LimitConfig
+	db     *badger.DB
+	// token bucket
+	tokens float64
+	filled time.Time
+	// daily budget
+	day  string
+	used int64
+	// the budget stored in badger, saving is true while it is being brought up to date
+	savedDay string
+	saved    int64
+	saving   bool
+	// circuit breaker
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newLimiter(db *badger.DB, config LimitConfig) *limiter {
+	return &limiter{
+		config: config,
+		db:     db,
+		tokens: config.burst(),
+		filled: time.Now(),
+	}
+}
+
+// burst is the number of tokens the bucket holds. It holds at least one so a QPS below 1 still lets a request through every 1/QPS seconds.
+func (c LimitConfig) burst() float64 {
+	return math.Max(c.QPS, 1)
+}
+
+// acquire reserves a provider request or returns the reason it may not be sent
+func (l *limiter) acquire(now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.config.BreakerFailures > 0 && l.failures >= l.config.BreakerFailures {
+		if l.probing || now.Sub(l.openedAt) < l.config.BreakerCooldown {
+			return ErrCircuitOpen
+		}
+		// half open: one request probes whether the provider recovered
+		l.probing = true
+	}
+	if l.config.QPS > 0 {
+		l.tokens += now.Sub(l.filled).Seconds() * l.config.QPS
+		if l.tokens > l.config.burst() {
+			l.tokens = l.config.burst()
+		}
+		l.filled = now
+		if l.tokens < 1 {
+			l.probing = false
+			return ErrRateLimited
+		}
+	}
+	if l.config.DailyBudget > 0 {
+		day := now.UTC().Format("2006-01-02")
+		if day != l.day {
+			used, err := l.loadBudget(day)
+			if err != nil {
+				l.probing = false
+				return err
+			}
+			l.day, l.used = day, used
+			l.savedDay, l.saved = day, used
+		}
+		if l.used >= l.config.DailyBudget {
+			l.probing = false
+			return ErrBudgetExceeded
+		}
+		l.used++
+		metrics.GaugeMapsBudgetRemaining(float64(l.config.DailyBudget - l.used))
+	}
+	if l.config.QPS > 0 {
+		l.tokens--
+	}
+	return nil
+}
+
+// release records the outcome of a provider request for the circuit breaker
+func (l *limiter) release(err error, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.config.BreakerFailures <= 0 {
+		return
+	}
+	l.probing = false
+	if err == nil || err == ErrNoRoute || err == ErrNoResults || err == context.Canceled {
+		if l.failures >= l.config.BreakerFailures {
+			metrics.GaugeMapsCircuitOpen(false)
+		}
+		l.failures = 0
+		return
+	}
+	l.failures++
+	if l.failures >= l.config.BreakerFailures {
+		if l.failures == l.config.BreakerFailures {
+			log.Errorf("maps provider failed %v times in a row, opening the circuit breaker: %s", l.failures, err.Error())
+		}
+		l.openedAt = now
+		metrics.GaugeMapsCircuitOpen(true)
+	}
+}
+
+func (l *limiter) loadBudget(day string) (int64, error) {
+	txn := l.db.NewTransaction(false)
+	defer txn.Discard()
+	item, err := txn.Get([]byte(budgetPrefix + day))
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if item.UserMeta() != budgetMeta {
+		return 0, nil
+	}
+	res, err := item.ValueCopy(nil)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(res), 10, 64)
+}
+
+// persistBudget stores the budget used today without holding the lock during the commit. Concurrent callers leave it to the one already
+// storing it, which keeps storing until the stored budget is current.
+func (l *limiter) persistBudget() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.saving {
+		return
+	}
+	l.saving = true
+	for l.day != l.savedDay || l.used != l.saved {
+		day, used := l.day, l.used
+		l.mu.Unlock()
+		err := l.saveBudget(day, used)
+		l.mu.Lock()
+		if err != nil {
+			log.Error(err.Error())
+			break
+		}
+		l.savedDay, l.saved = day, used
+	}
+	l.saving = false
+}
+
+func (l *limiter) saveBudget(day string, used int64) error {
+	txn := l.db.NewTransaction(true)
+	defer txn.Discard()
+	if err := txn.SetEntry(&badger.Entry{
+		Key:      []byte(budgetPrefix + day),
+		Value:    []byte(strconv.FormatInt(used, 10)),
+		UserMeta: budgetMeta,
+		// the budget of a day is only needed until the day is over
+		ExpiresAt: uint64(time.Now().Add(48 * time.Hour).Unix()),
+	}); err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+// call sends a provider request unless a limit forbids it
+func (c *Client) call(request string, fn func() error) error {
+	if err := c.limiter.acquire(time.Now()); err != nil {
+		metrics.IncMapsRequest(request, limitResult(err))
+		return err
+	}
+	if c.limiter.config.DailyBudget > 0 {
+		c.limiter.persistBudget()
+	}
+	err := fn()
+	c.limiter.release(err, time.Now())
+	if err != nil {
+		metrics.IncMapsRequest(request, "error")
+		return err
+	}
+	metrics.IncMapsRequest(request, "ok")
+	return nil
+}
+
+func limitResult(err error) string {
+	switch err {
+	case ErrRateLimited:
+		return "rate_limited"
+	case ErrBudgetExceeded:
+		return "budget_exceeded"
+	case ErrCircuitOpen:
+		return "circuit_open"
+	default:
+		return "error"
+	}
+}
+
+// straightLine describes the straight line between two points: the html directions(base64 encoded), eta in minutes at the speed of the travel
+// mode and distance in meters
+func straightLine(here, there *api.Point, mode api.TravelMode) (string, int, int) {
+	profile, ok := speedProfiles[mode]
+	if !ok {
+		profile = speedProfiles[api.TravelMode_Driving]
+	}
+	meters := geo.NewPointFromLatLng(here.Lat, here.Lon).GeoDistanceFrom(geo.NewPointFromLatLng(there.Lat, there.Lon), true)
+	html := fmt.Sprintf("\n<h5>Destination: %s</h5>Straight line - %s<br>", pointString(there), humanReadable(meters))
+	return base64.StdEncoding.EncodeToString([]byte(html)), int(meters / profile.speed / 60), int(meters)
+}
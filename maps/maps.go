@@ -6,41 +6,52 @@ import (
 	"encoding/json"
 	"fmt"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
 	"github.com/dgraph-io/badger/v2"
 	"github.com/gogo/protobuf/proto"
 	geo "github.com/paulmach/go.geo"
 	"googlemaps.github.io/maps"
-	"strings"
+	"sync"
 	"time"
 )
 
+// Client answers maps requests with a Provider and caches the responses in badger
 type Client struct {
-	googleMapsClient     *maps.Client
+	provider             Provider
 	db                   *badger.DB
 	precision            int
+	timezonePrecision    int
 	directionsExpiration time.Duration
+	cacheMu              sync.Mutex
+	caches               map[api.MapsCacheType]*cacheState
+	limiter              *limiter
 }
 
-const (
-	directionsMeta  = 2
-	timezoneMeta    = 3
-	addressMeta     = 4
-	coordinatesMeta = 5
-)
-
-func NewClient(db *badger.DB, apiKey string, directionsExpiration time.Duration) (*Client, error) {
-	client, err := maps.NewClient(maps.WithAPIKey(apiKey))
-	if err != nil {
-		return nil, err
-	}
-	return &Client{
-		googleMapsClient:     client,
+// NewClient creates a maps client answering requests with the provider within the limits
+func NewClient(db *badger.DB, provider Provider, cache CacheConfig, limits LimitConfig) *Client {
+	c := &Client{
+		provider:             provider,
 		db:                   db,
-		directionsExpiration: directionsExpiration,
-	}, nil
+		precision:            cache.Precision,
+		timezonePrecision:    cache.TimezonePrecision,
+		directionsExpiration: cache.DirectionsExpiration,
+		caches:               map[api.MapsCacheType]*cacheState{},
+		limiter:              newLimiter(db, limits),
+	}
+	if c.precision <= 0 {
+		c.precision = defaultCachePrecision
+	}
+	// points near a zone border must not share the cache entry of the other zone, so timezone keys are never coarser than address keys
+	if c.timezonePrecision < c.precision {
+		c.timezonePrecision = c.precision
+	}
+	for _, t := range cacheTypes {
+		c.caches[t] = &cacheState{maxEntries: cache.MaxEntries, entries: -1}
+	}
+	return c
 }
 
-func (c *Client) Directions(ctx context.Context, origin *api.Point, dest *api.Point, mode maps.Mode) ([]maps.Route, error) {
+func (c *Client) Directions(ctx context.Context, origin *api.Point, dest *api.Point, mode api.TravelMode) ([]maps.Route, error) {
 	res, err := c.getCachedDirections(origin, dest, mode)
 	if err != nil {
 		return nil, err
@@ -48,14 +59,11 @@ func (c *Client) Directions(ctx context.Context, origin *api.Point, dest *api.Po
 	if res != nil && len(res.Routes) > 0 {
 		return res.Routes, nil
 	}
-	resp, _, err := c.googleMapsClient.Directions(ctx, &maps.DirectionsRequest{
-		Origin:        c.PointString(origin),
-		Destination:   c.PointString(dest),
-		Mode:          mode,
-		DepartureTime: "now",
-		TrafficModel:  maps.TrafficModelBestGuess,
-	})
-	if err != nil {
+	var resp []maps.Route
+	if err := c.call("directions", func() (err error) {
+		resp, err = c.provider.Directions(ctx, origin, dest, mode)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 	if err := c.cacheDirections(origin, dest, mode, &RouteCache{
@@ -74,44 +82,13 @@ func (c *Client) GetAddress(point *api.Point) (*api.Address, error) {
 	if addr != nil && addr.Address != "" {
 		return addr, nil
 	}
-	location := &maps.LatLng{
-		Lat: point.Lat,
-		Lng: point.Lon,
-	}
-
-	req := &maps.GeocodingRequest{
-		LatLng: location,
-	}
-
-	resp, err := c.googleMapsClient.ReverseGeocode(context.Background(), req)
-	if err != nil {
+	var address *api.Address
+	if err := c.call("reverse_geocode", func() (err error) {
+		address, err = c.provider.ReverseGeocode(context.Background(), point)
+		return err
+	}); err != nil {
 		return nil, err
 	}
-
-	var address = &api.Address{}
-	for _, res := range resp {
-		address.Address = res.FormattedAddress
-		for _, addressComponent := range res.AddressComponents {
-			for _, t := range addressComponent.Types {
-				switch t {
-				case "administrative_area_level_1":
-					longName := addressComponent.LongName
-					address.State = longName
-				case "administrative_area_level_2":
-					address.County = addressComponent.LongName
-				case "country":
-					address.Country = addressComponent.LongName
-				case "postal_code":
-					address.Zip = addressComponent.LongName
-				case "locality", "sublocality":
-					address.City = addressComponent.LongName
-				default:
-					continue
-				}
-			}
-		}
-		break
-	}
 	if err := c.cacheAddress(point, address); err != nil {
 		return nil, err
 	}
@@ -126,33 +103,38 @@ func (c *Client) GetTimezone(point *api.Point) (string, error) {
 	if zone != "" {
 		return zone, nil
 	}
-	location := &maps.LatLng{
-		Lat: point.Lat,
-		Lng: point.Lon,
-	}
-	r := &maps.TimezoneRequest{
-		Location:  location,
-		Timestamp: time.Now(),
-	}
-	timezoneResult, err := c.googleMapsClient.Timezone(context.Background(), r)
-	if err != nil {
+	if err := c.call("timezone", func() (err error) {
+		zone, err = c.provider.Timezone(context.Background(), point)
+		return err
+	}); err != nil {
 		return "", err
 	}
-	if err := c.cacheTimezone(point, timezoneResult.TimeZoneID); err != nil {
+	if err := c.cacheTimezone(point, zone); err != nil {
 		return "", err
 	}
-	return timezoneResult.TimeZoneID, nil
+	return zone, nil
 }
 
 func (c *Client) PointString(point *api.Point) string {
+	return pointString(point)
+}
+
+func pointString(point *api.Point) string {
 	return fmt.Sprintf("%f, %f", point.Lat, point.Lon)
 }
 
-func (c *Client) TravelDetail(ctx context.Context, here, there *api.Point, mode maps.Mode) (string, int, int, error) {
+// TravelDetail returns the html directions(base64 encoded), eta in minutes and distance in meters between two points. If the provider
+// fails or a limit is hit, it degrades to the straight line between the points.
+func (c *Client) TravelDetail(ctx context.Context, here, there *api.Point, mode api.TravelMode) (string, int, int, error) {
 	directions, err := c.Directions(ctx, here, there, mode)
-	if err != nil {
+	if err == ErrNoProvider || err == ErrNoRoute {
 		return "", 0, 0, err
 	}
+	if err != nil {
+		metrics.IncMapsDegraded("directions")
+		html, eta, dist := straightLine(here, there, mode)
+		return html, eta, dist, nil
+	}
 	htmlDirections := fmt.Sprintf("\n<h5>Destination: %s</h5>", directions[0].Legs[len(directions[0].Legs)-1].EndAddress)
 	eta := 0
 	dist := 0
@@ -175,16 +157,11 @@ func (c *Client) GetCoordinates(address string) (*api.Point, error) {
 	if point != nil {
 		return point, nil
 	}
-	req := &maps.GeocodingRequest{
-		Address: address,
-	}
-	resp, err := c.googleMapsClient.Geocode(context.Background(), req)
-	if err != nil {
-		return &api.Point{}, err
-	}
-	point = &api.Point{
-		Lon: resp[0].Geometry.Location.Lng,
-		Lat: resp[0].Geometry.Location.Lat,
+	if err := c.call("geocode", func() (err error) {
+		point, err = c.provider.Geocode(context.Background(), address)
+		return err
+	}); err != nil {
+		return nil, err
 	}
 	if err := c.cacheCoordinates(address, point); err != nil {
 		return nil, err
@@ -196,125 +173,57 @@ type RouteCache struct {
 	Routes []maps.Route `json:"routes"`
 }
 
-func (c *Client) cacheDirections(origin, destination *api.Point, mode maps.Mode, routes *RouteCache) error {
+func (c *Client) cacheDirections(origin, destination *api.Point, mode api.TravelMode, routes *RouteCache) error {
 	orig, dest := geo.NewPointFromLatLng(origin.Lat, origin.Lon), geo.NewPointFromLatLng(destination.Lat, destination.Lon)
-	tx := c.db.NewTransaction(true)
-	defer tx.Discard()
 	bits, err := json.Marshal(routes)
 	if err != nil {
 		return err
 	}
-	if err := tx.SetEntry(&badger.Entry{
-		Key:       []byte(c.directionsCacheKey(orig, dest, mode)),
-		Value:     bits,
-		UserMeta:  directionsMeta,
-		ExpiresAt: uint64(time.Now().Add(c.directionsExpiration).Unix()),
-	}); err != nil {
-		return err
-	}
-	if err := tx.Commit(); err != nil {
-		return err
-	}
-	return nil
+	return c.setCached(api.MapsCacheType_DirectionsCache, c.directionsCacheKey(orig, dest, mode), bits, c.directionsExpiration)
 }
 
-func (c *Client) getCachedDirections(origin, destination *api.Point, mode maps.Mode) (*RouteCache, error) {
-	tx := c.db.NewTransaction(false)
-	defer tx.Discard()
+func (c *Client) getCachedDirections(origin, destination *api.Point, mode api.TravelMode) (*RouteCache, error) {
 	orig, dest := geo.NewPointFromLatLng(origin.Lat, origin.Lon), geo.NewPointFromLatLng(destination.Lat, destination.Lon)
-	item, err := tx.Get([]byte(c.directionsCacheKey(orig, dest, mode)))
-	if err != nil {
+	res, err := c.getCached(api.MapsCacheType_DirectionsCache, c.directionsCacheKey(orig, dest, mode))
+	if err != nil || len(res) == 0 {
 		return nil, err
 	}
-	res, err := item.ValueCopy(nil)
-	if err != nil {
+	var routes = &RouteCache{}
+	if err := json.Unmarshal(res, routes); err != nil {
 		return nil, err
 	}
-	if len(res) > 0 {
-		var routes = &RouteCache{}
-		if err := json.Unmarshal(res, routes); err != nil {
-			return nil, err
-		}
-	}
-
-	return nil, nil
+	return routes, nil
 }
 
 func (c *Client) cacheAddress(point *api.Point, addr *api.Address) error {
 	if addr == nil {
 		return nil
 	}
-	gpoint := geo.NewPointFromLatLng(point.Lat, point.Lon)
-	tx := c.db.NewTransaction(true)
-	defer tx.Discard()
 	bits, err := proto.Marshal(addr)
 	if err != nil {
 		return err
 	}
-	if err := tx.SetEntry(&badger.Entry{
-		Key:       []byte(c.addressCacheKey(gpoint)),
-		Value:     bits,
-		UserMeta:  addressMeta,
-		ExpiresAt: 0,
-	}); err != nil {
-		return err
-	}
-	if err := tx.Commit(); err != nil {
-		return err
-	}
-	return nil
+	return c.setCached(api.MapsCacheType_AddressCache, c.addressCacheKey(geo.NewPointFromLatLng(point.Lat, point.Lon)), bits, 0)
 }
 
 func (c *Client) getCachedAddress(point *api.Point) (*api.Address, error) {
-	gpoint := geo.NewPointFromLatLng(point.Lat, point.Lon)
-	tx := c.db.NewTransaction(false)
-	defer tx.Discard()
-	item, err := tx.Get([]byte(c.addressCacheKey(gpoint)))
-	if err != nil {
+	res, err := c.getCached(api.MapsCacheType_AddressCache, c.addressCacheKey(geo.NewPointFromLatLng(point.Lat, point.Lon)))
+	if err != nil || len(res) == 0 {
 		return nil, err
 	}
-	res, err := item.ValueCopy(nil)
-	if err != nil {
+	var address = &api.Address{}
+	if err := proto.Unmarshal(res, address); err != nil {
 		return nil, err
 	}
-	if len(res) > 0 {
-		var routes = &api.Address{}
-		if err := proto.Unmarshal(res, routes); err != nil {
-			return nil, err
-		}
-	}
-	return nil, nil
+	return address, nil
 }
 
 func (c *Client) cacheTimezone(point *api.Point, zone string) error {
-	gpoint := geo.NewPointFromLatLng(point.Lat, point.Lon)
-	tx := c.db.NewTransaction(true)
-	defer tx.Discard()
-	e := &badger.Entry{
-		Key:       []byte(c.timezoneCacheKey(gpoint)),
-		Value:     []byte(zone),
-		UserMeta:  timezoneMeta,
-		ExpiresAt: 0,
-	}
-
-	if err := tx.SetEntry(e); err != nil {
-		return err
-	}
-	if err := tx.Commit(); err != nil {
-		return err
-	}
-	return nil
+	return c.setCached(api.MapsCacheType_TimezoneCache, c.timezoneCacheKey(geo.NewPointFromLatLng(point.Lat, point.Lon)), []byte(zone), 0)
 }
 
 func (c *Client) getCachedTimezone(point *api.Point) (string, error) {
-	gpoint := geo.NewPointFromLatLng(point.Lat, point.Lon)
-	tx := c.db.NewTransaction(false)
-	defer tx.Discard()
-	item, err := tx.Get([]byte(c.timezoneCacheKey(gpoint)))
-	if err != nil {
-		return "", err
-	}
-	res, err := item.ValueCopy(nil)
+	res, err := c.getCached(api.MapsCacheType_TimezoneCache, c.timezoneCacheKey(geo.NewPointFromLatLng(point.Lat, point.Lon)))
 	if err != nil {
 		return "", err
 	}
@@ -322,65 +231,21 @@ func (c *Client) getCachedTimezone(point *api.Point) (string, error) {
 }
 
 func (c *Client) cacheCoordinates(address string, point *api.Point) error {
-	tx := c.db.NewTransaction(true)
-	defer tx.Discard()
 	bits, err := proto.Marshal(point)
 	if err != nil {
 		return err
 	}
-	e := &badger.Entry{
-		Key:       []byte(c.coordinatesCacheKey(address)),
-		Value:     []byte(bits),
-		UserMeta:  coordinatesMeta,
-		ExpiresAt: 0,
-	}
-
-	if err := tx.SetEntry(e); err != nil {
-		return err
-	}
-	if err := tx.Commit(); err != nil {
-		return err
-	}
-	return nil
+	return c.setCached(api.MapsCacheType_CoordinatesCache, c.coordinatesCacheKey(address), bits, 0)
 }
 
 func (c *Client) getCachedCoordinates(address string) (*api.Point, error) {
-	tx := c.db.NewTransaction(false)
-	defer tx.Discard()
-	item, err := tx.Get([]byte(c.coordinatesCacheKey(address)))
-	if err != nil {
+	res, err := c.getCached(api.MapsCacheType_CoordinatesCache, c.coordinatesCacheKey(address))
+	if err != nil || len(res) == 0 {
 		return nil, err
 	}
-	res, err := item.ValueCopy(nil)
-	if err != nil {
+	var point = &api.Point{}
+	if err := proto.Unmarshal(res, point); err != nil {
 		return nil, err
 	}
-	if len(res) > 0 {
-		var point = &api.Point{}
-		if err := proto.Unmarshal(res, point); err != nil {
-			return nil, err
-		}
-		return point, nil
-	}
-	return nil, nil
-}
-
-func (c *Client) directionsCacheKey(origin, destination *geo.Point, mode maps.Mode) string {
-	originHash := origin.GeoHash(9)
-	destHash := destination.GeoHash(c.precision)
-	return fmt.Sprintf("gmaps_directions_%s_%s_%s", mode, originHash, destHash)
-}
-
-func (c *Client) addressCacheKey(point *geo.Point) string {
-	hash := point.GeoHash(9)
-	return fmt.Sprintf("gmaps_address_%s", hash)
-}
-
-func (c *Client) timezoneCacheKey(point *geo.Point) string {
-	hash := point.GeoHash(4)
-	return fmt.Sprintf("gmaps_timezone_%s", hash)
-}
-
-func (c *Client) coordinatesCacheKey(address string) string {
-	return fmt.Sprintf("gmaps_coordinates_%s", base64.StdEncoding.EncodeToString([]byte(strings.ToLower(strings.TrimSpace(address)))))
+	return point, nil
 }
@@ -0,0 +1,53 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"googlemaps.github.io/maps"
+)
+
+// ErrNoProvider is returned if no provider is configured for a request
+var ErrNoProvider = errors.New("maps: no provider configured")
+
+// Provider answers every kind of maps request: directions, reverse geocoding, geocoding and timezones
+type Provider interface {
+	RoutingProvider
+	GeocodingProvider
+	TimezoneProvider
+}
+
+// Providers combines a provider per kind of request into a Provider. Requests without a provider fail with ErrNoProvider.
+type Providers struct {
+	Routing   RoutingProvider
+	Geocoding GeocodingProvider
+	Timezones TimezoneProvider
+}
+
+func (p *Providers) Directions(ctx context.Context, origin, dest *api.Point, mode api.TravelMode) ([]maps.Route, error) {
+	if p.Routing == nil {
+		return nil, ErrNoProvider
+	}
+	return p.Routing.Directions(ctx, origin, dest, mode)
+}
+
+func (p *Providers) ReverseGeocode(ctx context.Context, point *api.Point) (*api.Address, error) {
+	if p.Geocoding == nil {
+		return nil, ErrNoProvider
+	}
+	return p.Geocoding.ReverseGeocode(ctx, point)
+}
+
+func (p *Providers) Geocode(ctx context.Context, address string) (*api.Point, error) {
+	if p.Geocoding == nil {
+		return nil, ErrNoProvider
+	}
+	return p.Geocoding.Geocode(ctx, address)
+}
+
+func (p *Providers) Timezone(ctx context.Context, point *api.Point) (string, error) {
+	if p.Timezones == nil {
+		return "", ErrNoProvider
+	}
+	return p.Timezones.Timezone(ctx, point)
+}
@@ -0,0 +1,17 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"googlemaps.github.io/maps"
+)
+
+// ErrNoRoute is returned by a RoutingProvider if the destination can't be reached from the origin
+var ErrNoRoute = errors.New("maps: no route found")
+
+// RoutingProvider computes the routes between two points for a travel mode. Routes are returned in the google maps format
+// so every provider can be cached and rendered the same way.
+type RoutingProvider interface {
+	Directions(ctx context.Context, origin, dest *api.Point, mode api.TravelMode) ([]maps.Route, error)
+}
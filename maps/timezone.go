@@ -0,0 +1,162 @@
+package maps
+
+import (
+	"context"
+	"fmt"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	geojson "github.com/paulmach/go.geojson"
+	"io/ioutil"
+	"math"
+	"time"
+)
+
+// TimezoneProvider looks up the IANA timezone id of a point
+type TimezoneProvider interface {
+	Timezone(ctx context.Context, point *api.Point) (string, error)
+}
+
+// zoneCellSize is the size of the cells(in degrees) of the index of timezone boundaries
+const zoneCellSize = 1.0
+
+type zone struct {
+	id string
+	// polygons of rings of [lon, lat] coordinates, the first ring is the exterior
+	polygons                       [][][][]float64
+	minLat, minLon, maxLat, maxLon float64
+}
+
+// TimezoneBoundaries is a TimezoneProvider returning the timezone whose boundary contains a point. Boundaries are the Polygon and
+// MultiPolygon features of a GeoJSON feature collection with the IANA timezone id in their tzid property(the format of
+// timezone-boundary-builder). Points outside of every boundary get the nautical timezone of their longitude(ex: Etc/GMT+5).
+type TimezoneBoundaries struct {
+	zones []*zone
+	grid  map[gridCell][]int
+}
+
+// LoadTimezoneBoundaries loads the timezone boundaries of the GeoJSON file at path
+func LoadTimezoneBoundaries(path string) (*TimezoneBoundaries, error) {
+	bits, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewTimezoneBoundaries(bits)
+}
+
+// NewTimezoneBoundaries indexes the timezone boundaries of a GeoJSON feature collection
+func NewTimezoneBoundaries(data []byte) (*TimezoneBoundaries, error) {
+	collection, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		return nil, err
+	}
+	t := &TimezoneBoundaries{
+		grid: map[gridCell][]int{},
+	}
+	for i, feature := range collection.Features {
+		if feature.Geometry == nil {
+			continue
+		}
+		z := &zone{
+			minLat: math.MaxFloat64,
+			minLon: math.MaxFloat64,
+			maxLat: -math.MaxFloat64,
+			maxLon: -math.MaxFloat64,
+		}
+		switch feature.Geometry.Type {
+		case geojson.GeometryPolygon:
+			z.polygons = [][][][]float64{feature.Geometry.Polygon}
+		case geojson.GeometryMultiPolygon:
+			z.polygons = feature.Geometry.MultiPolygon
+		default:
+			continue
+		}
+		z.id, err = feature.PropertyString("tzid")
+		if err != nil || z.id == "" {
+			return nil, fmt.Errorf("feature %d: missing tzid property", i)
+		}
+		if _, err := time.LoadLocation(z.id); err != nil {
+			return nil, fmt.Errorf("feature %d: %s", i, err.Error())
+		}
+		for _, polygon := range z.polygons {
+			if len(polygon) == 0 {
+				return nil, fmt.Errorf("feature %d: polygon without rings", i)
+			}
+			for _, ring := range polygon {
+				for _, coordinate := range ring {
+					if len(coordinate) < 2 {
+						return nil, fmt.Errorf("feature %d: invalid coordinate", i)
+					}
+				}
+			}
+			for _, coordinate := range polygon[0] {
+				z.minLon, z.maxLon = math.Min(z.minLon, coordinate[0]), math.Max(z.maxLon, coordinate[0])
+				z.minLat, z.maxLat = math.Min(z.minLat, coordinate[1]), math.Max(z.maxLat, coordinate[1])
+			}
+		}
+		id := len(t.zones)
+		t.zones = append(t.zones, z)
+		for lat := zoneCell(z.minLat); lat <= zoneCell(z.maxLat); lat++ {
+			for lon := zoneCell(z.minLon); lon <= zoneCell(z.maxLon); lon++ {
+				cell := gridCell{lat: lat, lon: lon}
+				t.grid[cell] = append(t.grid[cell], id)
+			}
+		}
+	}
+	if len(t.zones) == 0 {
+		return nil, fmt.Errorf("no timezone boundaries")
+	}
+	return t, nil
+}
+
+func zoneCell(degrees float64) int {
+	return int(math.Floor(degrees / zoneCellSize))
+}
+
+// Timezone returns the id of the timezone whose boundary contains the point
+func (t *TimezoneBoundaries) Timezone(ctx context.Context, point *api.Point) (string, error) {
+	for _, id := range t.grid[gridCell{lat: zoneCell(point.Lat), lon: zoneCell(point.Lon)}] {
+		if t.zones[id].contains(point) {
+			return t.zones[id].id, nil
+		}
+	}
+	// nautical timezones have the inverted sign of their offset
+	offset := int(math.Round(point.Lon / 15))
+	if offset == 0 {
+		return "Etc/GMT", nil
+	}
+	return fmt.Sprintf("Etc/GMT%+d", -offset), nil
+}
+
+func (z *zone) contains(point *api.Point) bool {
+	if point.Lat < z.minLat || point.Lat > z.maxLat || point.Lon < z.minLon || point.Lon > z.maxLon {
+		return false
+	}
+	for _, polygon := range z.polygons {
+		if !ringContains(polygon[0], point) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if ringContains(hole, point) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// ringContains uses the even-odd ray casting rule to determine whether the point is inside the ring of [lon, lat] coordinates
+func ringContains(ring [][]float64, point *api.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > point.Lat) != (yj > point.Lat) && point.Lon < (xj-xi)*(point.Lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -6,7 +6,8 @@ import (
 )
 
 func init() {
-	prometheus.MustRegister(objectLat, objectLon)
+	prometheus.MustRegister(objectLat, objectLon, streamDropped, streamDisconnected, streamBuffer, mapsCacheHits, mapsCacheMisses, mapsCacheEvictions,
+		mapsRequests, mapsBudgetRemaining, mapsCircuitOpen, mapsDegraded, enrichmentQueue, enrichmentDropped)
 }
 
 var (
@@ -18,9 +19,114 @@ var (
 		Name: "object_longitude",
 		Help: "the objects longitude",
 	}, []string{"key"})
+	streamDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_dropped_messages_total",
+		Help: "the number of messages dropped because a stream clients buffer was full",
+	}, []string{"stream", "client"})
+	streamDisconnected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_disconnected_clients_total",
+		Help: "the number of stream clients disconnected because their buffer was full",
+	}, []string{"stream", "client"})
+	streamBuffer = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stream_buffer_depth",
+		Help: "the number of messages waiting in a stream clients buffer",
+	}, []string{"stream", "client"})
+	mapsCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "maps_cache_hits_total",
+		Help: "the number of maps requests answered from the cache",
+	}, []string{"cache"})
+	mapsCacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "maps_cache_misses_total",
+		Help: "the number of maps requests that missed the cache",
+	}, []string{"cache"})
+	mapsCacheEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "maps_cache_evictions_total",
+		Help: "the number of maps cache entries evicted because the cache exceeded its bound",
+	}, []string{"cache"})
+	mapsRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "maps_requests_total",
+		Help: "the number of maps provider requests by result(ok, error, rate_limited, budget_exceeded, circuit_open)",
+	}, []string{"request", "result"})
+	mapsBudgetRemaining = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "maps_budget_remaining",
+		Help: "the number of maps provider requests left in the daily budget",
+	})
+	mapsCircuitOpen = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "maps_circuit_open",
+		Help: "1 while the maps provider circuit breaker is open",
+	})
+	mapsDegraded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "maps_degraded_total",
+		Help: "the number of maps requests answered with a straight line because the provider failed",
+	}, []string{"request"})
+	enrichmentQueue = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "enrichment_queue_depth",
+		Help: "the number of objects waiting for their maps lookups in async enrichment mode",
+	})
+	enrichmentDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "enrichment_dropped_total",
+		Help: "the number of maps lookups dropped because the enrichment queue was full",
+	})
 )
 
 func GaugeObjectLocation(key string, point *api.Point) {
 	objectLat.WithLabelValues(key).Set(point.Lat)
 	objectLon.WithLabelValues(key).Set(point.Lon)
 }
+
+func IncStreamDropped(stream, client string) {
+	streamDropped.WithLabelValues(stream, client).Inc()
+}
+
+func IncStreamDisconnected(stream, client string) {
+	streamDisconnected.WithLabelValues(stream, client).Inc()
+}
+
+func GaugeStreamBuffer(stream, client string, depth int) {
+	streamBuffer.WithLabelValues(stream, client).Set(float64(depth))
+}
+
+// RemoveStreamBuffer removes the buffer depth of a client that left
+func RemoveStreamBuffer(stream, client string) {
+	streamBuffer.DeleteLabelValues(stream, client)
+}
+
+func IncMapsCacheHit(cache string) {
+	mapsCacheHits.WithLabelValues(cache).Inc()
+}
+
+func IncMapsCacheMiss(cache string) {
+	mapsCacheMisses.WithLabelValues(cache).Inc()
+}
+
+func AddMapsCacheEvictions(cache string, evicted int) {
+	mapsCacheEvictions.WithLabelValues(cache).Add(float64(evicted))
+}
+
+func IncMapsRequest(request, result string) {
+	mapsRequests.WithLabelValues(request, result).Inc()
+}
+
+func GaugeMapsBudgetRemaining(remaining float64) {
+	mapsBudgetRemaining.Set(remaining)
+}
+
+func GaugeMapsCircuitOpen(open bool) {
+	if open {
+		mapsCircuitOpen.Set(1)
+		return
+	}
+	mapsCircuitOpen.Set(0)
+}
+
+func IncMapsDegraded(request string) {
+	mapsDegraded.WithLabelValues(request).Inc()
+}
+
+func GaugeEnrichmentQueue(depth int) {
+	enrichmentQueue.Set(float64(depth))
+}
+
+func IncEnrichmentDropped() {
+	enrichmentDropped.Inc()
+}
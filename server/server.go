@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"github.com/autom8ter/geodb/auth"
 	"github.com/autom8ter/geodb/config"
+	geodb "github.com/autom8ter/geodb/db"
 	"github.com/autom8ter/geodb/maps"
 	"github.com/autom8ter/geodb/stream"
+	"github.com/autom8ter/geodb/webhook"
 	"github.com/dgraph-io/badger/v2"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -25,6 +27,7 @@ import (
 	"google.golang.org/grpc"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -70,15 +73,105 @@ func GetDeps() (*badger.DB, *stream.Hub, *maps.Client, error) {
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	hub := stream.NewHub()
-	if config.Config.IsSet("GEODB_GMAPS_KEY") {
-		client, err := maps.NewClient(db, config.Config.GetString("GEODB_GMAPS_KEY"), config.Config.GetDuration("GEODB_GMAPS_CACHE_DURATION"))
+	if err := geodb.RebuildGeohashIndex(db); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := geodb.RebuildTrackerIndex(db); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := geodb.RebuildGeofenceIndex(db); err != nil {
+		return nil, nil, nil, err
+	}
+	if config.Config.IsSet("GEODB_METADATA_INDEXES") {
+		for _, key := range strings.Split(config.Config.GetString("GEODB_METADATA_INDEXES"), ",") {
+			if key = strings.TrimSpace(key); key == "" {
+				continue
+			}
+			if err := geodb.AddMetadataIndex(db, key); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+	policy, err := stream.ParseOverflowPolicy(config.Config.GetString("GEODB_STREAM_OVERFLOW_POLICY"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := geodb.ParseStaleWritePolicy(config.Config.GetString("GEODB_STALE_WRITE_POLICY")); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := geodb.ParseEnrichmentOverflowPolicy(config.Config.GetString("GEODB_ENRICHMENT_OVERFLOW_POLICY")); err != nil {
+		return nil, nil, nil, err
+	}
+	hub := stream.NewHub(config.Config.GetInt("GEODB_STREAM_BUFFER_SIZE"), policy)
+	provider, err := mapsProvider()
+	if err != nil {
+		return db, hub, nil, err
+	}
+	if provider == nil {
+		return db, hub, nil, nil
+	}
+	return db, hub, maps.NewClient(db, provider, maps.CacheConfig{
+		Precision:            config.Config.GetInt("GEODB_MAPS_CACHE_PRECISION"),
+		TimezonePrecision:    config.Config.GetInt("GEODB_TIMEZONE_CACHE_PRECISION"),
+		DirectionsExpiration: config.Config.GetDuration("GEODB_GMAPS_CACHE_DURATION"),
+		MaxEntries:           config.Config.GetInt64("GEODB_MAPS_CACHE_MAX_ENTRIES"),
+	}, maps.LimitConfig{
+		QPS:             config.Config.GetFloat64("GEODB_MAPS_QPS"),
+		DailyBudget:     config.Config.GetInt64("GEODB_MAPS_DAILY_BUDGET"),
+		BreakerFailures: config.Config.GetInt("GEODB_MAPS_BREAKER_FAILURES"),
+		BreakerCooldown: config.Config.GetDuration("GEODB_MAPS_BREAKER_COOLDOWN"),
+	}), nil
+}
+
+// mapsProvider returns the maps provider selected by GEODB_MAPS_PROVIDER or nil if none is configured. google maps is used by default
+// if GEODB_GMAPS_KEY is set, the local datasets replace it for their kind of requests.
+func mapsProvider() (maps.Provider, error) {
+	kind := config.Config.GetString("GEODB_MAPS_PROVIDER")
+	switch kind {
+	case "fake":
+		return maps.LoadFake(config.Config.GetString("GEODB_FAKE_MAPS_SCRIPT"))
+	case "", "google", "offline":
+	default:
+		return nil, fmt.Errorf("unknown maps provider: %s", kind)
+	}
+	providers := &maps.Providers{}
+	if kind == "google" || (kind == "" && config.Config.IsSet("GEODB_GMAPS_KEY")) {
+		google, err := maps.NewGoogle(config.Config.GetString("GEODB_GMAPS_KEY"))
+		if err != nil {
+			return nil, err
+		}
+		providers.Routing, providers.Geocoding, providers.Timezones = google, google, google
+	}
+	if config.Config.IsSet("GEODB_ROUTING_GRAPH") {
+		router, err := maps.LoadGraphRouter(config.Config.GetString("GEODB_ROUTING_GRAPH"))
+		if err != nil {
+			return nil, err
+		}
+		providers.Routing = router
+	}
+	// the gazetteer is a fallback for deployments without google maps, it never replaces google's geocoding
+	if config.Config.IsSet("GEODB_GAZETTEER") {
+		if providers.Geocoding != nil {
+			log.Warn("GEODB_GAZETTEER is ignored, google maps geocodes addresses")
+		} else {
+			gazetteer, err := maps.LoadGazetteer(config.Config.GetString("GEODB_GAZETTEER"), config.Config.GetFloat64("GEODB_GAZETTEER_MAX_DISTANCE"))
+			if err != nil {
+				return nil, err
+			}
+			providers.Geocoding = gazetteer
+		}
+	}
+	if config.Config.IsSet("GEODB_TIMEZONE_BOUNDARIES") {
+		boundaries, err := maps.LoadTimezoneBoundaries(config.Config.GetString("GEODB_TIMEZONE_BOUNDARIES"))
 		if err != nil {
-			return db, hub, nil, err
+			return nil, err
 		}
-		return db, hub, client, err
+		providers.Timezones = boundaries
+	}
+	if providers.Routing == nil && providers.Geocoding == nil && providers.Timezones == nil {
+		return nil, nil
 	}
-	return db, stream.NewHub(), nil, nil
+	return providers, nil
 }
 
 func NewServer() (*Server, error) {
@@ -142,6 +235,21 @@ func (s *Server) Run() {
 	egp.Go(func() error {
 		return s.streamHub.StartObjectStream(ctx)
 	})
+	egp.Go(func() error {
+		return s.streamHub.StartGeofenceStream(ctx)
+	})
+	egp.Go(func() error {
+		return geodb.WatchDwell(ctx, s.db, s.streamHub)
+	})
+	egp.Go(func() error {
+		return geodb.WatchExpirations(ctx, s.db, s.streamHub)
+	})
+	egp.Go(func() error {
+		return geodb.WatchEnrichments(ctx)
+	})
+	egp.Go(func() error {
+		return webhook.NewDispatcher(s.db, s.streamHub, s.hTTPClient).Start(ctx)
+	})
 	egp.Go(func() error {
 		for {
 			time.Sleep(config.Config.GetDuration("GEODB_GC_INTERVAL"))
@@ -6,19 +6,27 @@ import (
 	"github.com/autom8ter/geodb/maps"
 	"github.com/autom8ter/geodb/stream"
 	"github.com/dgraph-io/badger/v2"
+	"net/http"
 )
 
 type GeoDB struct {
 	hub   *stream.Hub
 	db    *badger.DB
 	gmaps *maps.Client
+	// client delivers redelivered webhook dead letters
+	client *http.Client
 }
 
-func NewGeoDB(db *badger.DB, hub *stream.Hub, gmaps *maps.Client) *GeoDB {
+// NewGeoDB returns the GeoDB service. client delivers webhooks, http.DefaultClient is used if it is nil.
+func NewGeoDB(db *badger.DB, hub *stream.Hub, gmaps *maps.Client, client *http.Client) *GeoDB {
+	if client == nil {
+		client = http.DefaultClient
+	}
 	return &GeoDB{
-		hub:   hub,
-		db:    db,
-		gmaps: gmaps,
+		hub:    hub,
+		db:     db,
+		gmaps:  gmaps,
+		client: client,
 	}
 }
 
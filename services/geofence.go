@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	log "github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) SetGeofence(ctx context.Context, r *api.SetGeofenceRequest) (*api.SetGeofenceResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fence, err := db.SetGeofence(p.db, r.Geofence)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SetGeofenceResponse{
+		Geofence: fence,
+	}, nil
+}
+
+func (p *GeoDB) GetGeofences(ctx context.Context, r *api.GetGeofencesRequest) (*api.GetGeofencesResponse, error) {
+	fences, err := db.GetGeofences(p.db, r.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetGeofencesResponse{
+		Geofences: fences,
+	}, nil
+}
+
+func (p *GeoDB) DeleteGeofences(ctx context.Context, r *api.DeleteGeofencesRequest) (*api.DeleteGeofencesResponse, error) {
+	if err := db.DeleteGeofences(p.db, r.Keys); err != nil {
+		return nil, err
+	}
+	return &api.DeleteGeofencesResponse{}, nil
+}
+
+func (p *GeoDB) StreamGeofenceEvents(r *api.StreamGeofenceEventsRequest, ss api.GeoDB_StreamGeofenceEventsServer) error {
+	clientID := p.hub.AddGeofenceStreamClient(r.ClientId)
+	defer p.hub.RemoveGeofenceStreamClient(clientID)
+	events := p.hub.GetClientGeofenceStream(clientID)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream client disconnected: its buffer is full")
+			}
+			if len(r.GeofenceKeys) > 0 && !funk.ContainsString(r.GeofenceKeys, event.Geofence.Key) {
+				continue
+			}
+			if len(r.Transitions) > 0 && !funk.Contains(r.Transitions, event.Transition) {
+				continue
+			}
+			if err := ss.Send(&api.StreamGeofenceEventsResponse{
+				Event: event,
+			}); err != nil {
+				log.Error(err.Error())
+			}
+		case <-ss.Context().Done():
+			return nil
+		}
+	}
+}
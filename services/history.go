@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) GetHistory(ctx context.Context, r *api.GetHistoryRequest) (*api.GetHistoryResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, err := db.GetHistory(p.db, r.Key, r.FromUnix, r.ToUnix, r.Interval)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetHistoryResponse{
+		Objects: objects,
+	}, nil
+}
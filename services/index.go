@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) AddMetadataIndex(ctx context.Context, r *api.AddMetadataIndexRequest) (*api.AddMetadataIndexResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := db.AddMetadataIndex(p.db, r.Key); err != nil {
+		return nil, err
+	}
+	return &api.AddMetadataIndexResponse{}, nil
+}
+
+func (p *GeoDB) RemoveMetadataIndex(ctx context.Context, r *api.RemoveMetadataIndexRequest) (*api.RemoveMetadataIndexResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := db.RemoveMetadataIndex(p.db, r.Key); err != nil {
+		return nil, err
+	}
+	return &api.RemoveMetadataIndexResponse{}, nil
+}
+
+func (p *GeoDB) GetMetadataIndexes(ctx context.Context, r *api.GetMetadataIndexesRequest) (*api.GetMetadataIndexesResponse, error) {
+	return &api.GetMetadataIndexesResponse{
+		Keys: db.GetMetadataIndexes(p.db),
+	}, nil
+}
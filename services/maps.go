@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) GetMapsCacheStats(ctx context.Context, r *api.GetMapsCacheStatsRequest) (*api.GetMapsCacheStatsResponse, error) {
+	if p.gmaps == nil {
+		return nil, status.Error(codes.Unimplemented, "maps integration not set up")
+	}
+	stats, err := p.gmaps.CacheStats()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get maps cache stats: %s", err.Error())
+	}
+	return &api.GetMapsCacheStatsResponse{
+		Caches: stats,
+	}, nil
+}
+
+func (p *GeoDB) PurgeMapsCache(ctx context.Context, r *api.PurgeMapsCacheRequest) (*api.PurgeMapsCacheResponse, error) {
+	if p.gmaps == nil {
+		return nil, status.Error(codes.Unimplemented, "maps integration not set up")
+	}
+	for _, cache := range r.Types {
+		if _, ok := api.MapsCacheType_name[int32(cache)]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown maps cache: %v", cache)
+		}
+	}
+	purged, err := p.gmaps.PurgeCache(r.Types...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge maps cache: %s", err.Error())
+	}
+	return &api.PurgeMapsCacheResponse{
+		Purged: purged,
+	}, nil
+}
+
+func (p *GeoDB) BoundMapsCache(ctx context.Context, r *api.BoundMapsCacheRequest) (*api.BoundMapsCacheResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if p.gmaps == nil {
+		return nil, status.Error(codes.Unimplemented, "maps integration not set up")
+	}
+	if _, ok := api.MapsCacheType_name[int32(r.Type)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown maps cache: %v", r.Type)
+	}
+	evicted, err := p.gmaps.BoundCache(r.Type, r.MaxEntries)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to bound maps cache: %s", err.Error())
+	}
+	return &api.BoundMapsCacheResponse{
+		Evicted: evicted,
+	}, nil
+}
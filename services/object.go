@@ -2,57 +2,173 @@ package services
 
 import (
 	"context"
+	"github.com/autom8ter/geodb/config"
 	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 )
 
 func (p *GeoDB) Set(ctx context.Context, r *api.SetRequest) (*api.SetResponse, error) {
 	if err := r.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	objects, err := db.Set(p.db, p.gmaps, p.hub, r.Object)
+	return db.Set(p.db, p.gmaps, p.hub, r.Object, r.Precondition)
+}
+
+func (p *GeoDB) BatchSet(ctx context.Context, r *api.BatchSetRequest) (*api.BatchSetResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, err := db.BatchSet(p.db, p.gmaps, p.hub, r.Objects)
 	if err != nil {
 		return nil, err
 	}
-	return &api.SetResponse{
-		Object: objects,
+	return &api.BatchSetResponse{
+		Objects: objects,
 	}, nil
 }
 
+// SetStream writes the objects that arrived while the previous batch was being written together, up to GEODB_SET_STREAM_BATCH_SIZE objects
+// per write transaction, and acknowledges every object in the order it was received.
+func (p *GeoDB) SetStream(ss api.GeoDB_SetStreamServer) error {
+	batchSize := config.Config.GetInt("GEODB_SET_STREAM_BATCH_SIZE")
+	requests := make(chan *api.SetStreamRequest, batchSize)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(requests)
+		for {
+			r, err := ss.Recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+			select {
+			case requests <- r:
+			case <-ss.Context().Done():
+				return
+			}
+		}
+	}()
+	for {
+		r, ok := <-requests
+		if !ok {
+			select {
+			case err := <-recvErr:
+				return err
+			default:
+				return nil
+			}
+		}
+		batch := []*api.SetStreamRequest{r}
+	collect:
+		for len(batch) < batchSize {
+			select {
+			case r, ok := <-requests:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, r)
+			default:
+				break collect
+			}
+		}
+		responses := make([]*api.SetStreamResponse, len(batch))
+		var (
+			sets  []*api.SetRequest
+			valid []*api.SetStreamResponse
+		)
+		for i, r := range batch {
+			responses[i] = &api.SetStreamResponse{
+				Id:  r.Id,
+				Key: r.GetObject().GetKey(),
+			}
+			if err := r.Validate(); err != nil {
+				responses[i].Code = uint32(codes.InvalidArgument)
+				responses[i].Error = err.Error()
+				continue
+			}
+			sets = append(sets, &api.SetRequest{
+				Object:       r.Object,
+				Precondition: r.Precondition,
+			})
+			valid = append(valid, responses[i])
+		}
+		results, errs := db.SetMany(p.db, p.gmaps, p.hub, sets)
+		for i, resp := range valid {
+			if errs[i] != nil {
+				resp.Code = uint32(status.Code(errs[i]))
+				resp.Error = status.Convert(errs[i]).Message()
+				continue
+			}
+			resp.Sequence = results[i].GetObject().GetSequence()
+			resp.Version = results[i].GetObject().GetVersion()
+			resp.Skipped = results[i].Skipped
+		}
+		for _, resp := range responses {
+			if err := ss.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (p *GeoDB) GetRegex(ctx context.Context, r *api.GetRegexRequest) (*api.GetRegexResponse, error) {
-	objects, err := db.GetRegex(p.db, r.Regex)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.GetRegex(p.db, r.Regex, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetRegexResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
 	}, nil
 }
 
+func (p *GeoDB) GetRegexStream(r *api.GetRegexRequest, ss api.GeoDB_GetRegexStreamServer) error {
+	return db.GetRegex(p.db, r.Regex, r.Filter, r.PageToken, sendObjects(ss))
+}
+
 func (p *GeoDB) Get(ctx context.Context, r *api.GetRequest) (*api.GetResponse, error) {
-	objects, err := db.Get(p.db, r.Keys)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.Get(p.db, r.Keys, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
 	}, nil
 }
 
+func (p *GeoDB) GetStream(r *api.GetRequest, ss api.GeoDB_GetStreamServer) error {
+	return db.Get(p.db, r.Keys, r.Filter, r.PageToken, sendObjects(ss))
+}
+
 func (p *GeoDB) GetPrefix(ctx context.Context, r *api.GetPrefixRequest) (*api.GetPrefixResponse, error) {
-	objects, err := db.GetPrefix(p.db, r.Prefix)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.GetPrefix(p.db, r.Prefix, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.GetPrefixResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
 	}, nil
 }
 
+func (p *GeoDB) GetPrefixStream(r *api.GetPrefixRequest, ss api.GeoDB_GetPrefixStreamServer) error {
+	return db.GetPrefix(p.db, r.Prefix, r.Filter, r.PageToken, sendObjects(ss))
+}
+
 func (p *GeoDB) Delete(ctx context.Context, r *api.DeleteRequest) (*api.DeleteResponse, error) {
-	if err := db.Delete(p.db, r.Keys); err != nil {
+	if err := db.Delete(p.db, p.hub, r.Keys); err != nil {
 		return nil, err
 	}
 	return &api.DeleteResponse{}, nil
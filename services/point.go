@@ -17,5 +17,5 @@ func (p *GeoDB) GetPoint(ctx context.Context, r *api.GetPointRequest) (*api.GetP
 			Point: point,
 		}, nil
 	}
-	return nil, status.Error(codes.Unimplemented, "google maps integration not set up")
+	return nil, status.Error(codes.Unimplemented, "maps integration not set up")
 }
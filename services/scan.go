@@ -4,34 +4,208 @@ import (
 	"context"
 	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (p *GeoDB) ScanBound(ctx context.Context, r *api.ScanBoundRequest) (*api.ScanBoundResponse, error) {
-	objects, err := db.ScanBound(p.db, r.Bound, r.Keys)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanBound(p.db, r.Bound, r.Keys, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanBoundResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
 	}, nil
 }
 
+func (p *GeoDB) ScanBoundStream(r *api.ScanBoundRequest, ss api.GeoDB_ScanBoundStreamServer) error {
+	return db.ScanBound(p.db, r.Bound, r.Keys, r.Filter, r.PageToken, sendObjects(ss))
+}
+
 func (p *GeoDB) ScanRegexBound(ctx context.Context, r *api.ScanRegexBoundRequest) (*api.ScanRegexBoundResponse, error) {
-	objects, err := db.ScanRegexBound(p.db, r.Bound, r.Regex)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanRegexBound(p.db, r.Bound, r.Regex, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanRegexBoundResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
 	}, nil
 }
 
+func (p *GeoDB) ScanRegexBoundStream(r *api.ScanRegexBoundRequest, ss api.GeoDB_ScanRegexBoundStreamServer) error {
+	return db.ScanRegexBound(p.db, r.Bound, r.Regex, r.Filter, r.PageToken, sendObjects(ss))
+}
+
 func (p *GeoDB) ScanPrefixBound(ctx context.Context, r *api.ScanPrefixBoundRequest) (*api.ScanPrefixBoundResponse, error) {
-	objects, err := db.ScanPrefixBound(p.db, r.Bound, r.Prefix)
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanPrefixBound(p.db, r.Bound, r.Prefix, r.Filter, r.PageToken, fn)
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &api.ScanPrefixBoundResponse{
-		Objects: objects,
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanPrefixBoundStream(r *api.ScanPrefixBoundRequest, ss api.GeoDB_ScanPrefixBoundStreamServer) error {
+	return db.ScanPrefixBound(p.db, r.Bound, r.Prefix, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanPolygon(ctx context.Context, r *api.ScanPolygonRequest) (*api.ScanPolygonResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanPolygon(p.db, r.Polygon, r.Keys, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanPolygonResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanPolygonStream(r *api.ScanPolygonRequest, ss api.GeoDB_ScanPolygonStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanPolygon(p.db, r.Polygon, r.Keys, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanRegexPolygon(ctx context.Context, r *api.ScanRegexPolygonRequest) (*api.ScanRegexPolygonResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanRegexPolygon(p.db, r.Polygon, r.Regex, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanRegexPolygonResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanRegexPolygonStream(r *api.ScanRegexPolygonRequest, ss api.GeoDB_ScanRegexPolygonStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanRegexPolygon(p.db, r.Polygon, r.Regex, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanPrefixPolygon(ctx context.Context, r *api.ScanPrefixPolygonRequest) (*api.ScanPrefixPolygonResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanPrefixPolygon(p.db, r.Polygon, r.Prefix, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanPrefixPolygonResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanPrefixPolygonStream(r *api.ScanPrefixPolygonRequest, ss api.GeoDB_ScanPrefixPolygonStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanPrefixPolygon(p.db, r.Polygon, r.Prefix, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanBox(ctx context.Context, r *api.ScanBoxRequest) (*api.ScanBoxResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanBox(p.db, r.Box, r.Keys, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanBoxResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanBoxStream(r *api.ScanBoxRequest, ss api.GeoDB_ScanBoxStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanBox(p.db, r.Box, r.Keys, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanRegexBox(ctx context.Context, r *api.ScanRegexBoxRequest) (*api.ScanRegexBoxResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanRegexBox(p.db, r.Box, r.Regex, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanRegexBoxResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanRegexBoxStream(r *api.ScanRegexBoxRequest, ss api.GeoDB_ScanRegexBoxStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanRegexBox(p.db, r.Box, r.Regex, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) ScanPrefixBox(ctx context.Context, r *api.ScanPrefixBoxRequest) (*api.ScanPrefixBoxResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	objects, next, err := db.Page(r.PageSize, func(fn db.ObjectFunc) error {
+		return db.ScanPrefixBox(p.db, r.Box, r.Prefix, r.Filter, r.PageToken, fn)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ScanPrefixBoxResponse{
+		Objects:       objects,
+		NextPageToken: next,
+	}, nil
+}
+
+func (p *GeoDB) ScanPrefixBoxStream(r *api.ScanPrefixBoxRequest, ss api.GeoDB_ScanPrefixBoxStreamServer) error {
+	if err := r.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return db.ScanPrefixBox(p.db, r.Box, r.Prefix, r.Filter, r.PageToken, sendObjects(ss))
+}
+
+func (p *GeoDB) Nearest(ctx context.Context, r *api.NearestRequest) (*api.NearestResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	results, err := db.Nearest(p.db, r.Point, int(r.Limit), r.MaxDistance, r.Prefix, r.Regex)
+	if err != nil {
+		return nil, err
+	}
+	return &api.NearestResponse{
+		Results: results,
 	}, nil
 }
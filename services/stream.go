@@ -1,94 +1,186 @@
 package services
 
 import (
+	"context"
+	"github.com/autom8ter/geodb/db"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
 	log "github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"regexp"
 	"strings"
 )
 
 func (p *GeoDB) Stream(r *api.StreamRequest, ss api.GeoDB_StreamServer) error {
-	clientID := p.hub.AddObjectStreamClient(r.ClientId)
-	for {
-		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if len(r.Keys) > 0 {
-				if funk.ContainsString(r.Keys, msg.Object.Key) {
-					if err := ss.Send(&api.StreamResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
-			}
-		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
-		}
+	if err := db.ValidateMetadataFilter(r.Filter); err != nil {
+		return err
 	}
+	return p.streamObjects(ss.Context(), r.ClientId, r.FromSequence, func(obj *api.ObjectDetail) bool {
+		if !db.MatchMetadata(r.Filter, obj.GetObject().GetMetadata()) {
+			return false
+		}
+		return len(r.Keys) == 0 || funk.ContainsString(r.Keys, obj.GetObject().GetKey())
+	}, func(obj *api.ObjectDetail) error {
+		return ss.Send(&api.StreamResponse{
+			Object: obj,
+			Event:  obj.Event,
+		})
+	})
 }
 
 func (p *GeoDB) StreamRegex(r *api.StreamRegexRequest, ss api.GeoDB_StreamRegexServer) error {
+	if err := db.ValidateMetadataFilter(r.Filter); err != nil {
+		return err
+	}
+	rx, err := regexp.Compile(r.Regex)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to compile regex: %s", err.Error())
+	}
+	return p.streamObjects(ss.Context(), r.ClientId, r.FromSequence, func(obj *api.ObjectDetail) bool {
+		return db.MatchMetadata(r.Filter, obj.GetObject().GetMetadata()) && rx.MatchString(obj.GetObject().GetKey())
+	}, func(obj *api.ObjectDetail) error {
+		return ss.Send(&api.StreamRegexResponse{
+			Object: obj,
+			Event:  obj.Event,
+		})
+	})
+}
+
+func (p *GeoDB) StreamPrefix(r *api.StreamPrefixRequest, ss api.GeoDB_StreamPrefixServer) error {
+	if err := db.ValidateMetadataFilter(r.Filter); err != nil {
+		return err
+	}
+	return p.streamObjects(ss.Context(), r.ClientId, r.FromSequence, func(obj *api.ObjectDetail) bool {
+		return db.MatchMetadata(r.Filter, obj.GetObject().GetMetadata()) && strings.HasPrefix(obj.GetObject().GetKey(), r.Prefix)
+	}, func(obj *api.ObjectDetail) error {
+		return ss.Send(&api.StreamPrefixResponse{
+			Object: obj,
+			Event:  obj.Event,
+		})
+	})
+}
+
+// StreamBound sends the updates of objects inside the area. The objects inside the area are scanned once the client subscribed, from then on
+// the stream keeps track of them so an object that moves out of the area(or stops matching the filter) is sent once more with left set.
+func (p *GeoDB) StreamBound(r *api.StreamBoundRequest, ss api.GeoDB_StreamBoundServer) error {
+	if err := db.ValidateArea(r.Circle, r.Polygon); err != nil {
+		return err
+	}
+	if err := db.ValidateMetadataFilter(r.Filter); err != nil {
+		return err
+	}
 	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	objects, err := db.ScanArea(p.db, r.Circle, r.Polygon, r.Filter)
+	if err != nil {
+		return err
+	}
+	inside := map[string]bool{}
+	for key := range objects {
+		inside[key] = true
+	}
+	send := func(obj *api.ObjectDetail, left bool) {
+		if err := ss.Send(&api.StreamBoundResponse{
+			Object: obj,
+			Event:  obj.Event,
+			Left:   left,
+		}); err != nil {
+			log.Error(err.Error())
+		}
+	}
+	updates := p.hub.GetClientObjectStream(clientID)
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if r.Regex != "" {
-				match, err := regexp.MatchString(r.Regex, msg.Object.Key)
-				if err != nil {
-					return err
-				}
-				if match {
-					if err := ss.Send(&api.StreamRegexResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
+		case msg, ok := <-updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream client disconnected: its buffer is full")
+			}
+			key := msg.GetObject().GetKey()
+			switch msg.Event {
+			case api.ObjectEventType_DropAll:
+				inside = map[string]bool{}
+				send(msg, false)
+			case api.ObjectEventType_Deleted, api.ObjectEventType_Expired:
+				if inside[key] {
+					delete(inside, key)
+					send(msg, false)
 				}
-			} else {
-				if err := ss.Send(&api.StreamRegexResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
+			default:
+				if db.MatchMetadata(r.Filter, msg.GetObject().GetMetadata()) && db.AreaContains(r.Circle, r.Polygon, msg.GetObject().GetPoint()) {
+					inside[key] = true
+					send(msg, false)
+				} else if inside[key] {
+					delete(inside, key)
+					send(msg, true)
 				}
 			}
 		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			return nil
 		}
 	}
 }
 
-func (p *GeoDB) StreamPrefix(r *api.StreamPrefixRequest, ss api.GeoDB_StreamPrefixServer) error {
-	clientID := p.hub.AddObjectStreamClient(r.ClientId)
+// streamObjects replays the change log starting at fromSequence(if present), then sends the live object updates accepted by match until the
+// client disconnects. DropAll events concern every object so they are always sent. The client is subscribed before the replay finishes so no update is lost in between, duplicates are skipped by sequence.
+func (p *GeoDB) streamObjects(ctx context.Context, clientID string, fromSequence uint64, match func(obj *api.ObjectDetail) bool, send func(obj *api.ObjectDetail) error) error {
+	var last uint64
+	replay := func(from uint64) error {
+		read, err := db.ReadChangeLog(p.db, from, func(obj *api.ObjectDetail) error {
+			if obj.Event != api.ObjectEventType_DropAll && !match(obj) {
+				return nil
+			}
+			return send(obj)
+		})
+		if err != nil {
+			return err
+		}
+		last = read
+		return nil
+	}
+	if fromSequence > 0 {
+		if err := replay(fromSequence); err != nil {
+			return err
+		}
+	}
+	clientID = p.hub.AddObjectStreamClient(clientID)
+	defer p.hub.RemoveObjectStreamClient(clientID)
+	if fromSequence > 0 {
+		// catch up on the updates published while the change log was replayed
+		if err := replay(last + 1); err != nil {
+			return err
+		}
+	}
+	objects := p.hub.GetClientObjectStream(clientID)
 	for {
 		select {
-		case msg := <-p.hub.GetClientObjectStream(clientID):
-			if r.Prefix != "" {
-				if strings.HasPrefix(msg.Object.Key, r.Prefix) {
-					if err := ss.Send(&api.StreamPrefixResponse{
-						Object: msg,
-					}); err != nil {
-						log.Error(err.Error())
-					}
-				}
-			} else {
-				if err := ss.Send(&api.StreamPrefixResponse{
-					Object: msg,
-				}); err != nil {
-					log.Error(err.Error())
-				}
+		case msg, ok := <-objects:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream client disconnected: its buffer is full")
 			}
-		case <-ss.Context().Done():
-			p.hub.RemoveObjectStreamClient(clientID)
-			break
+			if msg.Sequence <= last || (msg.Event != api.ObjectEventType_DropAll && !match(msg)) {
+				continue
+			}
+			if err := send(msg); err != nil {
+				log.Error(err.Error())
+			}
+		case <-ctx.Done():
+			return nil
 		}
 	}
 }
+
+// objectSender is the server stream of a streamed listing(GetStream, ScanBoundStream...)
+type objectSender interface {
+	Send(*api.ObjectResult) error
+}
+
+// sendObjects sends every object of a listing as soon as it is read
+func sendObjects(ss objectSender) db.ObjectFunc {
+	return func(detail *api.ObjectDetail, pageToken string) error {
+		return ss.Send(&api.ObjectResult{
+			Object:    detail,
+			PageToken: pageToken,
+		})
+	}
+}
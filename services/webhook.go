@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/webhook"
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *GeoDB) SetWebhook(ctx context.Context, r *api.SetWebhookRequest) (*api.SetWebhookResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hook, err := db.SetWebhook(p.db, r.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SetWebhookResponse{
+		Webhook: hook,
+	}, nil
+}
+
+func (p *GeoDB) GetWebhooks(ctx context.Context, r *api.GetWebhooksRequest) (*api.GetWebhooksResponse, error) {
+	hooks, err := db.GetWebhooks(p.db, r.Ids)
+	if err != nil {
+		return nil, err
+	}
+	// secrets sign deliveries, they are never handed back out
+	for id, hook := range hooks {
+		hook = proto.Clone(hook).(*api.Webhook)
+		hook.Secret = ""
+		hooks[id] = hook
+	}
+	return &api.GetWebhooksResponse{
+		Webhooks: hooks,
+	}, nil
+}
+
+func (p *GeoDB) DeleteWebhooks(ctx context.Context, r *api.DeleteWebhooksRequest) (*api.DeleteWebhooksResponse, error) {
+	if err := db.DeleteWebhooks(p.db, r.Ids); err != nil {
+		return nil, err
+	}
+	return &api.DeleteWebhooksResponse{}, nil
+}
+
+func (p *GeoDB) GetDeadLetters(ctx context.Context, r *api.GetDeadLettersRequest) (*api.GetDeadLettersResponse, error) {
+	deliveries, err := db.GetDeadLetters(p.db, r.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetDeadLettersResponse{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (p *GeoDB) RedeliverDeadLetters(ctx context.Context, r *api.RedeliverDeadLettersRequest) (*api.RedeliverDeadLettersResponse, error) {
+	deliveries, err := db.GetDeadLetters(p.db, "")
+	if err != nil {
+		return nil, err
+	}
+	var delivered []string
+	for _, delivery := range deliveries {
+		if !funk.ContainsString(r.Ids, delivery.Id) {
+			continue
+		}
+		hooks, err := db.GetWebhooks(p.db, []string{delivery.WebhookId})
+		if err != nil {
+			return nil, err
+		}
+		if err := webhook.Deliver(ctx, p.client, hooks[delivery.WebhookId], delivery.Payload, config.Config.GetDuration("GEODB_WEBHOOK_TIMEOUT")); err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		delivered = append(delivered, delivery.Id)
+	}
+	if err := db.DeleteDeadLetters(p.db, delivered); err != nil {
+		return nil, err
+	}
+	return &api.RedeliverDeadLettersResponse{
+		Delivered: delivered,
+	}, nil
+}
@@ -2,67 +2,196 @@ package stream
 
 import (
 	"context"
+	"fmt"
 	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/metrics"
 	"github.com/gofrs/uuid"
 	"sync"
 )
 
-var objectChan = make(chan *api.ObjectDetail, 5000)
+// OverflowPolicy decides what happens to a message published to a client whose buffer is full
+type OverflowPolicy string
 
+const (
+	// DropOldest discards the oldest buffered message of the client to make room for the new one
+	DropOldest OverflowPolicy = "drop_oldest"
+	// DropNewest discards the new message
+	DropNewest OverflowPolicy = "drop_newest"
+	// Disconnect removes the client from the hub and closes its channel
+	Disconnect OverflowPolicy = "disconnect"
+)
+
+const (
+	objectStream   = "object"
+	geofenceStream = "geofence"
+	// publishBufferSize is the number of published messages that may wait for the hub to fan them out
+	publishBufferSize = 5000
+)
+
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch OverflowPolicy(policy) {
+	case DropOldest, DropNewest, Disconnect:
+		return OverflowPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("unknown stream overflow policy: %s", policy)
+	}
+}
+
+// Hub fans published messages out to its clients. Every client has a buffer of bufferSize messages so a slow client never blocks
+// the hub or other clients, the overflow policy applies once its buffer is full.
 type Hub struct {
-	objectClients map[string]chan *api.ObjectDetail
-	objMu         *sync.Mutex
+	objects         chan *api.ObjectDetail
+	geofenceEvents  chan *api.GeofenceEvent
+	objectClients   map[string]chan *api.ObjectDetail
+	objMu           *sync.Mutex
+	geofenceClients map[string]chan *api.GeofenceEvent
+	geofenceMu      *sync.Mutex
+	bufferSize      int
+	policy          OverflowPolicy
+	// reliableObjects and reliableGeofences map the ids of the clients the hub waits for to a channel closed once they are removed
+	reliableObjects   map[string]chan struct{}
+	reliableGeofences map[string]chan struct{}
+	reliableMu        *sync.Mutex
 }
 
-func NewHub() *Hub {
+func NewHub(bufferSize int, policy OverflowPolicy) *Hub {
 	return &Hub{
-		objectClients: map[string]chan *api.ObjectDetail{},
-		objMu:         &sync.Mutex{},
+		objects:           make(chan *api.ObjectDetail, publishBufferSize),
+		geofenceEvents:    make(chan *api.GeofenceEvent, publishBufferSize),
+		objectClients:     map[string]chan *api.ObjectDetail{},
+		objMu:             &sync.Mutex{},
+		geofenceClients:   map[string]chan *api.GeofenceEvent{},
+		geofenceMu:        &sync.Mutex{},
+		bufferSize:        bufferSize,
+		policy:            policy,
+		reliableObjects:   map[string]chan struct{}{},
+		reliableGeofences: map[string]chan struct{}{},
+		reliableMu:        &sync.Mutex{},
+	}
+}
+
+// reliable returns the channel closed once the reliable client is removed, false if the client isn't reliable
+func (h *Hub) reliable(clients map[string]chan struct{}, id string) (chan struct{}, bool) {
+	h.reliableMu.Lock()
+	defer h.reliableMu.Unlock()
+	removed, ok := clients[id]
+	return removed, ok
+}
+
+// releaseReliable stops the hub from waiting for the client, so removing it doesn't wait for the hub
+func (h *Hub) releaseReliable(clients map[string]chan struct{}, id string) {
+	h.reliableMu.Lock()
+	defer h.reliableMu.Unlock()
+	if removed, ok := clients[id]; ok {
+		close(removed)
+		delete(clients, id)
 	}
 }
 
 func (h *Hub) StartObjectStream(ctx context.Context) error {
 	for {
 		select {
-		case obj := <-objectChan:
-			if h.objectClients == nil {
-				h.objectClients = map[string]chan *api.ObjectDetail{}
-			}
-
-			for _, channel := range h.objectClients {
-				if channel != nil {
-					channel <- obj
+		case obj := <-h.objects:
+			h.objMu.Lock()
+			for id, channel := range h.objectClients {
+				if !h.pushObject(id, channel, obj) {
+					h.removeObjectClient(id)
 				}
 			}
+			h.objMu.Unlock()
 		case <-ctx.Done():
-			break
+			return nil
+		}
+	}
+}
+
+// pushObject adds the object to the clients buffer without blocking. It returns false if the client must be disconnected.
+func (h *Hub) pushObject(id string, channel chan *api.ObjectDetail, obj *api.ObjectDetail) bool {
+	defer func() {
+		metrics.GaugeStreamBuffer(objectStream, id, len(channel))
+	}()
+	if removed, ok := h.reliable(h.reliableObjects, id); ok {
+		select {
+		case channel <- obj:
+		case <-removed:
 		}
+		return true
+	}
+	select {
+	case channel <- obj:
+		return true
+	default:
 	}
+	switch h.policy {
+	case Disconnect:
+		metrics.IncStreamDisconnected(objectStream, id)
+		return false
+	case DropOldest:
+		select {
+		case <-channel:
+		default:
+		}
+		// the hub is the only sender, so there is room now
+		select {
+		case channel <- obj:
+		default:
+		}
+	}
+	metrics.IncStreamDropped(objectStream, id)
+	return true
 }
 
+// AddObjectStreamClient adds a client to the hub and returns its id. Ids that are empty or already taken get a generated id(suffix), so
+// a client never takes over the channel of another one.
 func (h *Hub) AddObjectStreamClient(clientID string) string {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
-	if h.objectClients == nil {
-		h.objectClients = map[string]chan *api.ObjectDetail{}
+	clientID = uniqueClientID(clientID, func(id string) bool {
+		_, ok := h.objectClients[id]
+		return ok
+	})
+	h.objectClients[clientID] = make(chan *api.ObjectDetail, h.bufferSize)
+	return clientID
+}
+
+// AddReliableObjectStreamClient adds a client that never misses a message: instead of applying the overflow policy the hub waits for room in
+// its buffer, which holds up every other client meanwhile. It is meant for internal consumers that must see every message(webhooks).
+func (h *Hub) AddReliableObjectStreamClient(clientID string) string {
+	clientID = h.AddObjectStreamClient(clientID)
+	h.reliableMu.Lock()
+	defer h.reliableMu.Unlock()
+	h.reliableObjects[clientID] = make(chan struct{})
+	return clientID
+}
+
+// uniqueClientID returns clientID, a generated id if clientID is empty or clientID suffixed with a generated id if it is taken
+func uniqueClientID(clientID string, taken func(id string) bool) string {
+	if clientID != "" && !taken(clientID) {
+		return clientID
 	}
+	id, _ := uuid.NewV4()
 	if clientID == "" {
-		id, _ := uuid.NewV4()
-		clientID = id.String()
+		return id.String()
 	}
-	h.objectClients[clientID] = make(chan *api.ObjectDetail)
-	return clientID
+	return fmt.Sprintf("%s-%s", clientID, id.String())
 }
 
 func (h *Hub) RemoveObjectStreamClient(id string) {
+	h.releaseReliable(h.reliableObjects, id)
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
-	if _, ok := h.objectClients[id]; ok {
-		close(h.objectClients[id])
+	h.removeObjectClient(id)
+}
+
+func (h *Hub) removeObjectClient(id string) {
+	if channel, ok := h.objectClients[id]; ok {
+		close(channel)
 		delete(h.objectClients, id)
+		metrics.RemoveStreamBuffer(objectStream, id)
 	}
 }
 
+// GetClientObjectStream returns the clients channel. The channel is closed once the client is removed or disconnected.
 func (h *Hub) GetClientObjectStream(id string) chan *api.ObjectDetail {
 	h.objMu.Lock()
 	defer h.objMu.Unlock()
@@ -72,10 +201,109 @@ func (h *Hub) GetClientObjectStream(id string) chan *api.ObjectDetail {
 	return nil
 }
 
-func PublishObject(obj *api.ObjectDetail) {
-	objectChan <- obj
+func (h *Hub) PublishObject(obj *api.ObjectDetail) {
+	h.objects <- obj
+}
+
+func (h *Hub) StartGeofenceStream(ctx context.Context) error {
+	for {
+		select {
+		case event := <-h.geofenceEvents:
+			h.geofenceMu.Lock()
+			for id, channel := range h.geofenceClients {
+				if !h.pushGeofenceEvent(id, channel, event) {
+					h.removeGeofenceClient(id)
+				}
+			}
+			h.geofenceMu.Unlock()
+		case <-ctx.Done():
+			return nil
+		}
+	}
 }
 
-func (h *Hub) PublishObject(obj *api.ObjectDetail) {
-	PublishObject(obj)
+// pushGeofenceEvent adds the event to the clients buffer without blocking. It returns false if the client must be disconnected.
+func (h *Hub) pushGeofenceEvent(id string, channel chan *api.GeofenceEvent, event *api.GeofenceEvent) bool {
+	defer func() {
+		metrics.GaugeStreamBuffer(geofenceStream, id, len(channel))
+	}()
+	if removed, ok := h.reliable(h.reliableGeofences, id); ok {
+		select {
+		case channel <- event:
+		case <-removed:
+		}
+		return true
+	}
+	select {
+	case channel <- event:
+		return true
+	default:
+	}
+	switch h.policy {
+	case Disconnect:
+		metrics.IncStreamDisconnected(geofenceStream, id)
+		return false
+	case DropOldest:
+		select {
+		case <-channel:
+		default:
+		}
+		select {
+		case channel <- event:
+		default:
+		}
+	}
+	metrics.IncStreamDropped(geofenceStream, id)
+	return true
+}
+
+// AddGeofenceStreamClient adds a client to the hub and returns its id. Ids that are empty or already taken get a generated id(suffix), so
+// a client never takes over the channel of another one.
+func (h *Hub) AddGeofenceStreamClient(clientID string) string {
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	clientID = uniqueClientID(clientID, func(id string) bool {
+		_, ok := h.geofenceClients[id]
+		return ok
+	})
+	h.geofenceClients[clientID] = make(chan *api.GeofenceEvent, h.bufferSize)
+	return clientID
+}
+
+// AddReliableGeofenceStreamClient adds a client the hub waits for instead of applying the overflow policy, like AddReliableObjectStreamClient
+func (h *Hub) AddReliableGeofenceStreamClient(clientID string) string {
+	clientID = h.AddGeofenceStreamClient(clientID)
+	h.reliableMu.Lock()
+	defer h.reliableMu.Unlock()
+	h.reliableGeofences[clientID] = make(chan struct{})
+	return clientID
+}
+
+func (h *Hub) RemoveGeofenceStreamClient(id string) {
+	h.releaseReliable(h.reliableGeofences, id)
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	h.removeGeofenceClient(id)
+}
+
+func (h *Hub) removeGeofenceClient(id string) {
+	if channel, ok := h.geofenceClients[id]; ok {
+		close(channel)
+		delete(h.geofenceClients, id)
+		metrics.RemoveStreamBuffer(geofenceStream, id)
+	}
+}
+
+// GetClientGeofenceStream returns the clients channel. The channel is closed once the client is removed or disconnected.
+func (h *Hub) GetClientGeofenceStream(id string) chan *api.GeofenceEvent {
+	h.geofenceMu.Lock()
+	defer h.geofenceMu.Unlock()
+	if channel, ok := h.geofenceClients[id]; ok {
+		return channel
+	}
+	return nil
+}
+
+func (h *Hub) PublishGeofenceEvent(event *api.GeofenceEvent) {
+	h.geofenceEvents <- event
 }
@@ -0,0 +1,279 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/autom8ter/geodb/config"
+	"github.com/autom8ter/geodb/db"
+	api "github.com/autom8ter/geodb/gen/go/geodb"
+	"github.com/autom8ter/geodb/stream"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	log "github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body, prefixed with "sha256="
+	SignatureHeader = "X-GeoDB-Signature"
+	EventHeader     = "X-GeoDB-Event"
+	DeliveryHeader  = "X-GeoDB-Delivery"
+	// ClientID is the stream hub client id the dispatcher subscribes with
+	ClientID = "_geodb_webhooks"
+)
+
+var marshaler = &jsonpb.Marshaler{}
+
+// Dispatcher POSTs the events published through the stream hub to every matching webhook, retrying failed deliveries with an
+// exponential backoff and parking them in the dead letter queue after GEODB_WEBHOOK_MAX_ATTEMPTS.
+type Dispatcher struct {
+	db          *badger.DB
+	hub         *stream.Hub
+	client      *http.Client
+	deliveries  chan *api.WebhookDelivery
+	workers     int
+	maxAttempts int
+	backoff     time.Duration
+	timeout     time.Duration
+}
+
+func NewDispatcher(db *badger.DB, hub *stream.Hub, client *http.Client) *Dispatcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Dispatcher{
+		db:          db,
+		hub:         hub,
+		client:      client,
+		deliveries:  make(chan *api.WebhookDelivery, config.Config.GetInt("GEODB_WEBHOOK_QUEUE_SIZE")),
+		workers:     config.Config.GetInt("GEODB_WEBHOOK_WORKERS"),
+		maxAttempts: config.Config.GetInt("GEODB_WEBHOOK_MAX_ATTEMPTS"),
+		backoff:     config.Config.GetDuration("GEODB_WEBHOOK_BACKOFF"),
+		timeout:     config.Config.GetDuration("GEODB_WEBHOOK_TIMEOUT"),
+	}
+}
+
+// Start subscribes to the stream hub and delivers events until the context is cancelled. The dispatcher subscribes as a reliable client, so
+// the stream overflow policy never drops its events: the hub waits for it while its deliveries queue is full.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	objectClient := d.hub.AddReliableObjectStreamClient(ClientID)
+	defer d.hub.RemoveObjectStreamClient(objectClient)
+	geofenceClient := d.hub.AddReliableGeofenceStreamClient(ClientID)
+	defer d.hub.RemoveGeofenceStreamClient(geofenceClient)
+	objects := d.hub.GetClientObjectStream(objectClient)
+	geofenceEvents := d.hub.GetClientGeofenceStream(geofenceClient)
+	for i := 0; i < d.workers; i++ {
+		go d.work(ctx)
+	}
+	for {
+		select {
+		case obj := <-objects:
+			switch obj.Event {
+			case api.ObjectEventType_Deleted, api.ObjectEventType_DropAll:
+				d.dispatch(ctx, &api.WebhookPayload{
+					EventType:     api.WebhookEventType_ObjectDeleted,
+					TimestampUnix: time.Now().Unix(),
+					Object:        obj,
+				})
+				continue
+			case api.ObjectEventType_Expired:
+				d.dispatch(ctx, &api.WebhookPayload{
+					EventType:     api.WebhookEventType_ObjectExpired,
+					TimestampUnix: obj.GetObject().GetExpiresUnix(),
+					Object:        obj,
+				})
+				continue
+			case api.ObjectEventType_Enriched:
+				d.dispatch(ctx, &api.WebhookPayload{
+					EventType:     api.WebhookEventType_ObjectEnriched,
+					TimestampUnix: obj.GetObject().GetUpdatedUnix(),
+					Object:        obj,
+				})
+				if len(obj.TrackerEvents) > 0 {
+					d.dispatch(ctx, &api.WebhookPayload{
+						EventType:     api.WebhookEventType_TrackerUpdated,
+						TimestampUnix: obj.GetObject().GetUpdatedUnix(),
+						Object:        obj,
+					})
+				}
+				continue
+			}
+			d.dispatch(ctx, &api.WebhookPayload{
+				EventType:     api.WebhookEventType_ObjectUpdated,
+				TimestampUnix: obj.GetObject().GetUpdatedUnix(),
+				Object:        obj,
+			})
+			if len(obj.TrackerEvents) > 0 {
+				d.dispatch(ctx, &api.WebhookPayload{
+					EventType:     api.WebhookEventType_TrackerUpdated,
+					TimestampUnix: obj.GetObject().GetUpdatedUnix(),
+					Object:        obj,
+				})
+			}
+		case event := <-geofenceEvents:
+			d.dispatch(ctx, &api.WebhookPayload{
+				EventType:     api.WebhookEventType_GeofenceTriggered,
+				TimestampUnix: event.TimestampUnix,
+				GeofenceEvent: event,
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// dispatch queues a delivery of the payload for every webhook that matches it
+func (d *Dispatcher) dispatch(ctx context.Context, payload *api.WebhookPayload) {
+	hooks, err := db.GetWebhooks(d.db, nil)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, hook := range hooks {
+		if !Match(hook, payload) {
+			continue
+		}
+		id, _ := uuid.NewV4()
+		select {
+		case d.deliveries <- &api.WebhookDelivery{
+			Id:        id.String(),
+			WebhookId: hook.Id,
+			Payload: &api.WebhookPayload{
+				Id:            id.String(),
+				EventType:     payload.EventType,
+				TimestampUnix: payload.TimestampUnix,
+				Object:        payload.Object,
+				GeofenceEvent: payload.GeofenceEvent,
+			},
+		}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case delivery := <-d.deliveries:
+			d.deliver(ctx, delivery)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver attempts the delivery until it succeeds or every attempt failed, in which case it is parked in the dead letter queue
+func (d *Dispatcher) deliver(ctx context.Context, delivery *api.WebhookDelivery) {
+	backoff := d.backoff
+	for {
+		hooks, err := db.GetWebhooks(d.db, []string{delivery.WebhookId})
+		if err != nil {
+			// the webhook was deleted or can't be read, park the delivery so it can be inspected and redelivered
+			delivery.LastError = err.Error()
+			delivery.FailedUnix = time.Now().Unix()
+			if err := db.AddDeadLetter(d.db, delivery); err != nil {
+				log.Error(err.Error())
+			}
+			return
+		}
+		delivery.Attempts++
+		err = Deliver(ctx, d.client, hooks[delivery.WebhookId], delivery.Payload, d.timeout)
+		if err == nil {
+			return
+		}
+		delivery.LastError = err.Error()
+		if int(delivery.Attempts) >= d.maxAttempts {
+			delivery.FailedUnix = time.Now().Unix()
+			if err := db.AddDeadLetter(d.db, delivery); err != nil {
+				log.Error(err.Error())
+			}
+			return
+		}
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Deliver POSTs the payload to the webhooks url once. Responses other than 2xx are errors.
+func Deliver(ctx context.Context, client *http.Client, hook *api.Webhook, payload *api.WebhookPayload, timeout time.Duration) error {
+	body, err := marshaler.MarshalToString(payload)
+	if err != nil {
+		return err
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequest(http.MethodPost, hook.Url, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, payload.EventType.String())
+	req.Header.Set(DeliveryHeader, payload.Id)
+	if hook.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(hook.Secret, []byte(body)))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		buf := &bytes.Buffer{}
+		buf.ReadFrom(resp.Body)
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, buf.String())
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body using the secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Match returns true if the payload passes every filter of the webhook
+func Match(hook *api.Webhook, payload *api.WebhookPayload) bool {
+	if len(hook.EventTypes) > 0 && !funk.Contains(hook.EventTypes, payload.EventType) {
+		return false
+	}
+	key := payload.GetObject().GetObject().GetKey()
+	if payload.GeofenceEvent != nil {
+		key = payload.GetGeofenceEvent().GetObject().GetKey()
+	}
+	// dropping every object concerns every key
+	if payload.GetObject().GetEvent() == api.ObjectEventType_DropAll {
+		return true
+	}
+	if len(hook.Keys) > 0 && !funk.ContainsString(hook.Keys, key) {
+		return false
+	}
+	if !strings.HasPrefix(key, hook.Prefix) {
+		return false
+	}
+	if hook.Regex != "" {
+		match, err := regexp.MatchString(hook.Regex, key)
+		if err != nil || !match {
+			return false
+		}
+	}
+	return true
+}